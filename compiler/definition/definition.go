@@ -1,6 +1,8 @@
 package definition
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -8,12 +10,15 @@ import (
 	"os/exec"
 	"path"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"gomate.io/gomate/logging"
+	"gomate.io/gomate/unbrokenwing"
 )
 
+// ErrFailed is returned by Definitions.Run when at least one scenario failed.
+var ErrFailed = errors.New("scenarios failed")
+
 var emptyLineRexexp = regexp.MustCompile("^[\t ]*$")
 
 // Definition represents a parsed step definition, typically located in step_definition folder underneath features folder.
@@ -47,8 +52,8 @@ func (definitions stepDefinitions) Code() string {
 
 // Code method generates source code based on a step definition.
 // The step definition are located into a go function named setup, this function sets
-// up all definition just before the parsement of the feature file that has been supplied as first
-// argument on commandline. Second argument must be text string "true" or "false", that enables
+// up all definition just before the parsement of the feature supplied on STDIN.
+// Command line flags configures unbrokenwing.Settings e.g., -pretty that enables
 // and disables pretty print i.e., print to STDOUT with or without color.
 func (definition Definition) Code() string {
 	return fmt.Sprintf(
@@ -60,20 +65,53 @@ func (definition Definition) Code() string {
 
 // Code method generates source code based on step definitions.
 // The composited step definitions are located into a go function named setup, this function sets
-// up all definition just before the parsement of the feature file that has been supplied as first
-// argument on commandline. Second argument must be text string "true" or "false", that enables
+// up all definition just before the parsement of the feature supplied on STDIN.
+// Command line flags configures unbrokenwing.Settings e.g., -pretty that enables
 // and disables pretty print i.e., print to STDOUT with or without color.
 func (definitions Definitions) Code() string {
 	return definitions.defs.Code()
 }
 
+// ParameterTypes returns parameter types registered by the compiled step definitions,
+// including built-in parameter types. The regular expression of each parameter type
+// are keyed by parameter type name.
+func (definitions Definitions) ParameterTypes() (map[string]string, error) {
+	if definitions.removed {
+		return nil, errors.New("compiled behaviour binary file has been removed")
+	}
+
+	file, err := ioutil.TempFile("", "gomate-parameter-types-")
+	if err != nil {
+		return nil, err
+	}
+
+	file.Close()
+	defer os.Remove(file.Name())
+
+	if err := definitions.Run(strings.NewReader(""), unbrokenwing.Settings{ParameterTypes: file.Name()}); err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(file.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	types := map[string]string{}
+
+	return types, json.Unmarshal(data, &types)
+}
+
 // Run takes a DSL written feature from io.Reader and supply the data into precompiled behaviour code.
-// After execution of the binary, the result are written to STDOUT. Method respects global.PPrint to
-// enable/disable pretty print i.e., colors enabled.
-func (definitions Definitions) Run(features io.Reader, pprint bool) {
+// After execution of the binary, the result are written to STDOUT. Settings are supplied to the binary
+// e.g., to enable/disable pretty print i.e., colors enabled.
+//
+// Output are written even if the binary exits unsuccessfully e.g., crashes, in which case an error is returned.
+// ErrFailed is returned when the binary succeeded to test the feature, but at least one scenario failed.
+func (definitions Definitions) Run(features io.Reader, settings unbrokenwing.Settings) error {
 	if definitions.removed {
 		logging.Info("Compiled behaviour binary file has been removed")
-		return
+		return nil
 	}
 
 	featureLines, err := ioutil.ReadAll(features)
@@ -82,7 +120,7 @@ func (definitions Definitions) Run(features io.Reader, pprint bool) {
 		logging.Fatal(err.Error())
 	}
 
-	gorun := exec.Command(definitions.command, strconv.FormatBool(pprint)) // #nosec
+	gorun := exec.Command(definitions.command, settings.Args()...) // #nosec
 	if stdin, err := gorun.StdinPipe(); err != nil {
 		logging.Fatal(err.Error())
 	} else if n, err := stdin.Write(featureLines); err != nil {
@@ -93,15 +131,23 @@ func (definitions Definitions) Run(features io.Reader, pprint bool) {
 		logging.Fatal(err.Error())
 	}
 
-	if output, err := gorun.CombinedOutput(); err != nil {
-		logging.Fatal(err.Error())
-	} else {
-		logging.Info(string(output))
+	output, err := gorun.CombinedOutput()
+	logging.Info(string(output)) // Might contain reason, e.g. invalid step definitions
+
+	if exit, ok := err.(*exec.ExitError); ok && exit.ExitCode() == unbrokenwing.ExitFailure {
+		return ErrFailed
+	} else if err != nil {
+		return fmt.Errorf("behaviour binary failed: %s", err)
 	}
+
+	return nil
 }
 
 // NewDefinition reads and parse "in" assuming that it contains content from a step definition file.
 // Lines defining package names are omitted from resulting Definition instance.
+//
+// When "in" is an *os.File, line directives are added to the generated code, so that positions
+// reported by the compiler and runtime refers to the step definition file instead of generated code.
 func NewDefinition(in io.Reader) Definition {
 	code, err := ioutil.ReadAll(in)
 	if err != nil {
@@ -110,8 +156,14 @@ func NewDefinition(in io.Reader) Definition {
 
 	imports := []string{}
 	funcs := []string{}
+	file := ""
+	next := 0 // Line number expected to follow previous func row
 
-	for _, row := range strings.Split(string(code), "\n") {
+	if f, ok := in.(*os.File); ok {
+		file = f.Name()
+	}
+
+	for i, row := range strings.Split(string(code), "\n") {
 		if strings.HasPrefix(row, "import ") {
 			imports = append(imports, row)
 		} else if strings.HasPrefix(row, "package ") {
@@ -119,7 +171,12 @@ func NewDefinition(in io.Reader) Definition {
 		} else if emptyLineRexexp.MatchString(row) {
 			continue // Empty lines removed
 		} else {
+			if file != "" && next != i+1 {
+				row = fmt.Sprintf("/*line %s:%d*/", file, i+1) + row
+			}
+
 			funcs = append(funcs, row)
+			next = i + 2
 		}
 	}
 
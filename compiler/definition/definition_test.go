@@ -8,6 +8,7 @@ import (
 
 	"gomate.io/gomate/compiler/definition"
 	"gomate.io/gomate/logging"
+	"gomate.io/gomate/unbrokenwing"
 )
 
 func ExampleNewDefinition() {
@@ -37,14 +38,54 @@ import "strings"
 	// import (
 	// 	. "gomate.io/gomate/unbrokenwing"
 	// 	"github.com/dekelund/stdres"
-	// 	"strconv"
+	// 	"flag"
 	// 	"os"
-	// 	"log"
 	// 	"testing"
 	// )
 	//
 	// import "strings"  // FIXME: This will not work with "import ("
 	//
+	// func main() {
+	// 	settings := Settings{}
+	// 	settings.Flags(flag.CommandLine)
+	// 	flag.Parse()
+	//
+	// 	if settings.Pretty {
+	// 		stdres.EnableColor()
+	// 	} else {
+	// 		stdres.DisableColor()
+	// 	}
+	//
+	// 	setup()
+	//
+	// 	if settings.ParameterTypes != "" {
+	// 		if err := WriteParameterTypes(settings.ParameterTypes); err != nil {
+	// 			os.Stderr.WriteString(err.Error() + "\n")
+	// 			os.Exit(1)
+	// 		}
+	//
+	// 		return
+	// 	}
+	//
+	// 	features, err := ParseFeatures(settings.Feature, os.Stdin)
+	// 	if err != nil {
+	// 		os.Stderr.WriteString(err.Error() + "\n") // Step definitions might import fmt or log
+	// 		os.Exit(1)
+	// 	}
+	//
+	// 	suite := NewSuiteWithSettings(settings)
+	// 	t := testing.T{}
+	//
+	// 	for _, feature := range features {
+	// 		suite.Test(*feature, &t)
+	// 	}
+	//
+	// 	if t.Failed() {
+	// 		os.Exit(ExitFailure)
+	// 	}
+	// }
+	//
+	// // setup is located last, line directives in step definitions applies until end of file.
 	// func setup() {
 	// Given("^I'm successfully logged in as an admin in users pane$", func(args Args) error {
 	// 	_ = ioutil.Discard
@@ -55,30 +96,37 @@ import "strings"
 	// 	return Pending("Not implemented")
 	// })
 	// }
-	//
-	// func main() {
-	// 	file := os.Args[1]
-	// 	fd, err := os.Open(file)  // Open *.feature file
-	// 	if err != nil {
-	// 		log.Fatal("Error opening input file:", err)
-	// 	}
+}
 
-	// 	defer fd.Close()
+func ExampleDefinitions_ParameterTypes() {
+	definitions := definition.NewDefinitions([]io.Reader{
+		bytes.NewBufferString(`
+package step_definitions
 
-	// 	if pretty, err := strconv.ParseBool(os.Args[2]); err != nil {
-	// 		log.Fatal("Error configuring pretty print: ", err)
-	// 	} else if pretty {
-	// 		stdres.EnableColor()
-	// 	} else {
-	// 		stdres.DisableColor()
-	// 	}
+const roles = "admin|developer"
 
-	// 	setup()
-	// 	feature := NewFeature(fd)
-	// 	suite := NewSuite()
-	// 	t := testing.T{}
-	// 	suite.Test(*feature, &t)
-	// }
+ParameterType("role", roles, func(s string) (interface{}, error) {
+	return s, nil
+})
+
+Given("I'm logged in as {role}", func(role string) error {
+	return Pending("Not implemented")
+})
+	`),
+	}, false)
+
+	defer definitions.Remove()
+
+	types, err := definitions.ParameterTypes()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(types["role"])
+	fmt.Println(types["int"])
+	// Output:
+	// admin|developer
+	// -?\d+
 }
 
 func ExampleDefinitions_Run() {
@@ -132,7 +180,7 @@ Feature: Manage users
     And user hacker should have password changeme
 `)
 	logging.ReconfigureLogger(logging.Settings{Priority: syslog.LOG_INFO})
-	definitions.Run(features, false)
+	definitions.Run(features, unbrokenwing.Settings{})
 
 	// Output:
 	// Feature: Manage users
@@ -154,11 +202,11 @@ Feature: Manage users
 	//
 	//     You can implement step definition for undefined steps with these snippets:
 	//
-	//     And("^user hacker should have password changeme$", func(args Args) error {
+	//     Then("^only one user-record with name hacker should exist$", func(args Args) error {
 	//         return Pending("Not implemented")
 	//     })
 	//
-	//     Then("^only one user-record with name hacker should exist$", func(args Args) error {
+	//     Then("^user hacker should have password changeme$", func(args Args) error {
 	//         return Pending("Not implemented")
 	//     })
 	//
@@ -6,30 +6,54 @@ package main
 import (
 	. "gomate.io/gomate/unbrokenwing"
 	"github.com/dekelund/stdres"
-	"strconv"
+	"flag"
 	"os"
-	"log"
 	"testing"
 )
 
 %s  // FIXME: This will not work with "import ("
 
-func setup() {
-%s
-}
-
 func main() {
-	if pretty, err := strconv.ParseBool(os.Args[1]); err != nil {
-		log.Fatal("Error configuring pretty print: ", err)
-	} else if pretty {
+	settings := Settings{}
+	settings.Flags(flag.CommandLine)
+	flag.Parse()
+
+	if settings.Pretty {
 		stdres.EnableColor()
 	} else {
 		stdres.DisableColor()
 	}
 
 	setup()
-	feature := NewFeature(os.Stdin)
-	suite := NewSuite()
+
+	if settings.ParameterTypes != "" {
+		if err := WriteParameterTypes(settings.ParameterTypes); err != nil {
+			os.Stderr.WriteString(err.Error() + "\n")
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	features, err := ParseFeatures(settings.Feature, os.Stdin)
+	if err != nil {
+		os.Stderr.WriteString(err.Error() + "\n") // Step definitions might import fmt or log
+		os.Exit(1)
+	}
+
+	suite := NewSuiteWithSettings(settings)
 	t := testing.T{}
-	suite.Test(*feature, &t)
+
+	for _, feature := range features {
+		suite.Test(*feature, &t)
+	}
+
+	if t.Failed() {
+		os.Exit(ExitFailure)
+	}
+}
+
+// setup is located last, line directives in step definitions applies until end of file.
+func setup() {
+%s
 }`
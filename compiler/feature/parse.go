@@ -8,23 +8,25 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"gomate.io/gomate/logging"
 )
 
-func getFeaturePaths(path string) (list []string) {
+func getFeaturePaths(path string) (list []string, err error) {
 	dir, err := os.Open(path) // #nosec
-
 	if err != nil {
-		logging.Fatalf("Error opening input file: %s", err.Error())
+		return nil, fmt.Errorf("Error opening input file: %s", err)
 	}
 
 	defer dir.Close()
 
 	for names, err := dir.Readdirnames(10); err != io.EOF; names, err = dir.Readdirnames(10) {
 		if err != nil {
-			logging.Fatalf("Error listing files: %s", err.Error())
+			return nil, fmt.Errorf("Error listing files: %s", err)
 		}
 
 		for _, name := range names {
@@ -34,34 +36,32 @@ func getFeaturePaths(path string) (list []string) {
 			}
 
 			fpath, err := filepath.Abs(filepath.Join(path, name))
-
 			if err != nil {
-				logging.Fatal(err.Error())
+				return nil, err
 			}
 
 			list = append(list, fpath)
 		}
 	}
 
-	return list
+	return list, nil
 }
 
-func getDefinitonPaths(path string) (list []string) {
+func getDefinitonPaths(path string) (list []string, err error) {
 	if _, err := isDir(path); err != nil {
-		logging.Fatal(err.Error())
+		return nil, err
 	}
 
 	dir, err := os.Open(path) // #nosec
-
-	defer dir.Close()
-
 	if err != nil {
-		logging.Fatalf("Error opening input file: %s", err.Error())
+		return nil, fmt.Errorf("Error opening input file: %s", err)
 	}
 
+	defer dir.Close()
+
 	for names, err := dir.Readdirnames(10); err != io.EOF; names, err = dir.Readdirnames(10) {
 		if err != nil {
-			logging.Fatalf("Error listing files: %s", err.Error())
+			return nil, fmt.Errorf("Error listing files: %s", err)
 		}
 
 		for _, name := range names {
@@ -71,54 +71,95 @@ func getDefinitonPaths(path string) (list []string) {
 			}
 
 			defPath, err := filepath.Abs(filepath.Join(path, name))
-
 			if err != nil {
-				logging.Fatal(err.Error())
+				return nil, err
 			}
 
 			list = append(list, defPath)
 		}
 	}
 
-	return list
+	sort.Strings(list) // Definitions are registered in file name order
+
+	return list, nil
 }
 
 // List represents the files and subdirectories files from a feature folder, including step definitions.
+// Lines holds lines selecting scenarios to test, keyed by feature path. Features without lines
+// shall be tested in full.
 type List struct {
 	Features    []string
 	Definitions []string
+	Lines       map[string][]int
 }
 
+var selectorRegexp = regexp.MustCompile(`^(?P<path>.+\.feature)(?P<lines>(?::[0-9]+)+)$`)
+
 // ParseDir make use of tools input data to generate definions binary and features struct.
 // fpath represents a relative path, to a .feature file or a dir with .feature files.
+// A .feature file might be followed by line selectors i.e., path/to/file.feature:LINE[:LINE...],
+// selecting the scenarios containing any of the lines.
 // defPattern represents definitions folders name, shall be located in features directory.
 // Function returns a list of features found in features file/dir and corresponding definitions.
 // An error will be returned if error occur, if not caller are responsible to call Definitions.Remove().
 func ParseDir(fpath, defPattern string) (list List, err error) {
 	var dir bool
+	var lines []int
+
+	if matches := selectorRegexp.FindStringSubmatch(fpath); matches != nil {
+		for _, field := range strings.Split(matches[2], ":")[1:] {
+			line, _ := strconv.Atoi(field) // Digits only, according to regexp
+			lines = append(lines, line)
+		}
+
+		fpath = matches[1]
+	}
 
 	if fpath, err = filepath.Abs(fpath); err != nil {
 		logging.Fatal(err.Error())
 	}
 
+	if lines != nil {
+		list.Lines = map[string][]int{fpath: lines}
+	}
+
 	logging.Debug(fmt.Sprintf("Going to test %s\n", fpath))
 
 	if dir, err = isDir(fpath); err != nil {
 		return
-	} else if dir {
-		list.Features = getFeaturePaths(fpath)
-	} else {
+	} else if !dir {
 		list.Features = []string{fpath}
 		fpath = filepath.Dir(fpath) // Point fpath to dir
+	} else if list.Features, err = getFeaturePaths(fpath); err != nil {
+		return
 	}
 
-	list.Definitions = getDefinitonPaths(
+	list.Definitions, err = getDefinitonPaths(
 		path.Join(fpath, defPattern), //Dir with .go-definitions
 	)
 
 	return
 }
 
+// Paths returns path when it names a .feature file, or the .feature files in
+// path when it names a directory. Unlike ParseDir, no step definitions are
+// required.
+func Paths(fpath string) ([]string, error) {
+	fpath, err := filepath.Abs(fpath)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := isDir(fpath)
+	if err != nil {
+		return nil, err
+	} else if dir {
+		return getFeaturePaths(fpath)
+	}
+
+	return []string{fpath}, nil
+}
+
 func isDir(file string) (bool, error) {
 	inputFile, err := os.Open(file) // #nosec
 	if err != nil {
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	"gomate.io/gomate/gherkin/lint"
+)
+
+// config is read from a JSON file, gomate.json by default. Flags holds
+// values of global flags keyed by flag name, used unless the flag is
+// given on the command line. Settings missing in the file keeps their
+// default values.
+type config struct {
+	Lint  lint.Config
+	Flags map[string]interface{}
+}
+
+func (conf *config) UnmarshalJSON(bytes []byte) error {
+	fields := map[string]json.RawMessage{}
+
+	if err := json.Unmarshal(bytes, &fields); err != nil {
+		return err
+	}
+
+	for name, field := range fields {
+		var err error
+
+		if name == "lint" {
+			err = json.Unmarshal(field, &conf.Lint)
+		} else {
+			var value interface{}
+			err = json.Unmarshal(field, &value)
+			conf.Flags[name] = value
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (conf config) MarshalJSON() ([]byte, error) {
+	fields := map[string]interface{}{"lint": conf.Lint}
+
+	for name, value := range conf.Flags {
+		fields[name] = value
+	}
+
+	return json.Marshal(fields)
+}
+
+// defaultConfig returns configuration holding default values of the global
+// flags of app, except the config, help and version flags.
+func defaultConfig(app *cli.App) config {
+	conf := config{Lint: lint.DefaultConfig(), Flags: map[string]interface{}{}}
+
+	for _, f := range app.Flags {
+		switch f := f.(type) {
+		case *cli.BoolFlag:
+			conf.Flags[f.Name] = f.Value
+		case *cli.IntFlag:
+			conf.Flags[f.Name] = f.Value
+		case *cli.StringFlag:
+			conf.Flags[f.Name] = f.Value
+		}
+	}
+
+	for _, name := range []string{"config", "help", "version"} {
+		delete(conf.Flags, name)
+	}
+
+	return conf
+}
+
+// loadConfig reads config from path. A missing file is not an error
+// unless required, defaults are returned instead.
+func loadConfig(path string, required bool) (config, error) {
+	conf := config{Lint: lint.DefaultConfig(), Flags: map[string]interface{}{}}
+
+	bytes, err := ioutil.ReadFile(path) // #nosec
+	if os.IsNotExist(err) && !required {
+		return conf, nil
+	} else if err != nil {
+		return conf, err
+	}
+
+	err = json.Unmarshal(bytes, &conf)
+
+	return conf, err
+}
+
+// applyConfig reads the configuration file named by the config flag, c is the
+// context of app. Global flags not given on the command line are set to values
+// of the file.
+func applyConfig(c *cli.Context) error {
+	path := c.String("config")
+
+	conf, err := loadConfig(path, c.IsSet("config"))
+	if err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+
+	defaults := defaultConfig(c.App)
+
+	for name, value := range conf.Flags {
+		if _, ok := defaults.Flags[name]; !ok {
+			return fmt.Errorf("%s: unknown setting %q", path, name)
+		} else if c.IsSet(name) {
+			continue
+		}
+
+		if err := c.Set(name, fmt.Sprint(value)); err != nil {
+			return fmt.Errorf("%s: %s: %s", path, name, err)
+		}
+	}
+
+	settings.Lint = conf.Lint
+
+	return nil
+}
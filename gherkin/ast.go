@@ -0,0 +1,140 @@
+package gherkin
+
+// Location is the position of a node in a Gherkin document,
+// Line and Column starts at 1.
+type Location struct {
+	Line   int
+	Column int
+}
+
+// GherkinDocument is the syntax tree of a parsed feature file. URI names
+// the source of the document e.g., path of the feature file. Comments
+// holds all comment lines, except language headers.
+type GherkinDocument struct {
+	URI      string
+	Feature  *Feature
+	Comments []*Comment
+
+	descriptions map[int]int // First line of descriptions, keyed by line of their header
+}
+
+// Comment is a line beginning with "#", or text beginning with "#" following
+// tags on a tag line.
+type Comment struct {
+	Location
+	Text string
+}
+
+// Tag is a tag e.g., "@serial", preceding a feature, rule, scenario or examples.
+type Tag struct {
+	Location
+	Name string
+}
+
+// Feature is the root node of a Gherkin document. Keyword is the feature
+// keyword of the dialect selected by Language e.g., "Egenskap" in Swedish.
+type Feature struct {
+	Location
+	Tags        []*Tag
+	Language    string
+	Keyword     string
+	Name        string
+	Description string
+	Children    []*FeatureChild
+}
+
+// FeatureChild holds either a Rule, a Background or a Scenario.
+type FeatureChild struct {
+	Rule       *Rule
+	Background *Background
+	Scenario   *Scenario
+}
+
+// Rule groups backgrounds and scenarios within a feature.
+type Rule struct {
+	Location
+	Tags        []*Tag
+	Keyword     string
+	Name        string
+	Description string
+	Children    []*RuleChild
+}
+
+// RuleChild holds either a Background or a Scenario.
+type RuleChild struct {
+	Background *Background
+	Scenario   *Scenario
+}
+
+// Background holds steps preceding each scenario of a feature or rule.
+type Background struct {
+	Location
+	Keyword     string
+	Name        string
+	Description string
+	Steps       []*Step
+}
+
+// Scenario is a scenario, or a scenario outline when Examples isn't empty.
+type Scenario struct {
+	Location
+	Tags        []*Tag
+	Keyword     string
+	Name        string
+	Description string
+	Steps       []*Step
+	Examples    []*Examples
+}
+
+// Step is a step of a background or scenario. Keyword is written as in the
+// feature file, including trailing space if any e.g., "Givet ". Type is the
+// English keyword, i.e. "Given", "When", "Then", "And", "But" or "*".
+// A step might be followed by either a DocString or a DataTable.
+type Step struct {
+	Location
+	Keyword   string
+	Type      string
+	Text      string
+	DocString *DocString
+	DataTable *DataTable
+}
+
+// DocString is a multi line text argument of a step, enclosed by a Delimiter,
+// i.e. `"""` or "```". MediaType is text following the opening delimiter.
+type DocString struct {
+	Location
+	MediaType string
+	Content   string
+	Delimiter string
+}
+
+// DataTable is a table argument of a step.
+type DataTable struct {
+	Location
+	Rows []*TableRow
+}
+
+// TableRow is a row of a data table or examples table.
+type TableRow struct {
+	Location
+	Cells []*TableCell
+}
+
+// TableCell is a cell of a table row, Value is unescaped and trimmed from surrounding whitespace.
+type TableCell struct {
+	Location
+	Value string
+}
+
+// Examples holds values of a scenario outline, each row in TableBody
+// generates a scenario where <placeholders> are replaced by the value
+// of the column named by TableHeader.
+type Examples struct {
+	Location
+	Tags        []*Tag
+	Keyword     string
+	Name        string
+	Description string
+	TableHeader *TableRow
+	TableBody   []*TableRow
+}
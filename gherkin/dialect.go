@@ -0,0 +1,93 @@
+package gherkin
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//go:generate go run languages_gen.go
+
+// DefaultLanguage is the language of feature files without language header.
+const DefaultLanguage = "en"
+
+// Dialect holds keywords of a Gherkin language. Step keywords includes
+// trailing space, when the keyword is separated from the step text e.g.,
+// "Given " but "Sachant qu'". All step keywords includes "* ".
+type Dialect struct {
+	Language string // Language code e.g., "sv"
+	Name     string // English name of language e.g., "Swedish"
+	Native   string // Native name of language e.g., "Svenska"
+
+	Feature         []string
+	Rule            []string
+	Background      []string
+	Scenario        []string
+	ScenarioOutline []string
+	Examples        []string
+
+	Given []string
+	When  []string
+	Then  []string
+	And   []string
+	But   []string
+}
+
+var languageRegexp = regexp.MustCompile(`^[\t ]*#[\t ]*language[\t ]*:[\t ]*(?P<language>[a-zA-Z0-9_-]+)[\t ]*$`)
+
+// Language returns language of a "# language: sv" header line,
+// and false if line isn't a language header.
+func Language(line string) (string, bool) {
+	if matches := languageRegexp.FindStringSubmatch(line); matches != nil {
+		return matches[1], true
+	}
+
+	return "", false
+}
+
+// LookupDialect returns dialect of language, and false if language is unknown.
+func LookupDialect(language string) (*Dialect, bool) {
+	dialect, ok := dialects[language]
+	return dialect, ok
+}
+
+// Languages returns sorted codes of all known languages.
+func Languages() []string {
+	languages := []string{}
+
+	for language := range dialects {
+		languages = append(languages, language)
+	}
+
+	sort.Strings(languages)
+
+	return languages
+}
+
+// StepKeywords returns English step keywords, i.e. "Given", "When", "Then",
+// "And", "But" or "*", keyed by step keywords of dialect without trailing
+// space. Keywords of more than one kind are keyed to the first kind of them.
+func (dialect *Dialect) StepKeywords() map[string]string {
+	keywords := map[string]string{"*": "*"}
+
+	for _, kind := range []struct {
+		english  string
+		keywords []string
+	}{
+		{"Given", dialect.Given},
+		{"When", dialect.When},
+		{"Then", dialect.Then},
+		{"And", dialect.And},
+		{"But", dialect.But},
+	} {
+		for _, keyword := range kind.keywords {
+			keyword = strings.TrimSpace(keyword)
+
+			if _, ok := keywords[keyword]; !ok {
+				keywords[keyword] = kind.english
+			}
+		}
+	}
+
+	return keywords
+}
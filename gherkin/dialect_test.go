@@ -0,0 +1,46 @@
+package gherkin_test
+
+import (
+	"fmt"
+	"strings"
+
+	"gomate.io/gomate/gherkin"
+)
+
+func ExampleLookupDialect() {
+	language, _ := gherkin.Language("# language: sv")
+	dialect, _ := gherkin.LookupDialect(language)
+
+	fmt.Println(dialect.Native, dialect.Feature, dialect.Scenario)
+	fmt.Println(dialect.StepKeywords()["Givet"], dialect.StepKeywords()["Så"])
+
+	// Output:
+	// Svenska [Egenskap] [Scenario]
+	// Given Then
+}
+
+func ExampleLookupDialect_japanese() {
+	documents, err := gherkin.Parse("features/calculator.feature", strings.NewReader(`# language: ja
+機能: 電卓
+
+  シナリオ: 足し算
+    前提 電卓がある
+    もし 2 と 3 を足す
+    ならば 結果は 5 である
+`))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, pickle := range gherkin.Compile(documents[0]) {
+		for _, step := range pickle.Steps {
+			fmt.Printf("%s: %s\n", step.Type, step.Text)
+		}
+	}
+
+	// Output:
+	// Given: 電卓がある
+	// When: 2 と 3 を足す
+	// Then: 結果は 5 である
+}
@@ -0,0 +1,9 @@
+// Package gherkin provides Gherkin keywords of the languages, or dialects,
+// feature files might be written in. A feature file selects its dialect
+// by a "# language: sv" header, English is used by default.
+//
+// Keywords are generated from gherkin-languages.json, an unchanged copy of
+// the official Gherkin i18n keyword table published by Cucumber. Replace it
+// with a newer copy of the official table, and regenerate languages.go with
+// go generate, to support new languages or keywords.
+package gherkin
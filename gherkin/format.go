@@ -0,0 +1,264 @@
+package gherkin
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// Format parses Gherkin documents of src, and returns them in canonical
+// layout, see Fprint. uri names the source in parse errors.
+func Format(uri string, src []byte) ([]byte, error) {
+	documents, err := Parse(uri, bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	if err := Fprint(&buf, documents); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Fprint prints documents to w in canonical layout. Keywords are indented by
+// two spaces per level, tags of a node are printed on one line, data table
+// columns are aligned and doc strings are indented as their step arguments.
+// Descriptions are printed as written, and comments are printed before the
+// node or description line following them, indented as that node. Comments
+// following tags are printed after them. Language headers are printed
+// when the language changes, English is the default.
+func Fprint(w io.Writer, documents []*GherkinDocument) error {
+	p := &printer{}
+	language := DefaultLanguage
+
+	for i, document := range documents {
+		if i > 0 {
+			p.blank()
+		}
+
+		p.comments, p.descriptions = document.Comments, document.descriptions
+
+		if feature := document.Feature; feature.Language != language {
+			p.println(0, "# language: "+feature.Language)
+			language = feature.Language
+		}
+
+		p.feature(document.Feature)
+		p.flush(p.indent)
+	}
+
+	_, err := w.Write(p.buf.Bytes())
+
+	return err
+}
+
+// printer prints nodes of a document, comments are printed when a node
+// following them is printed.
+type printer struct {
+	buf          bytes.Buffer
+	comments     []*Comment
+	descriptions map[int]int // First line of descriptions, keyed by line of their header
+	indent       int         // Indentation of last printed line
+	empty        bool        // True if last printed line is empty
+}
+
+// flush prints comments preceding line, or all remaining comments without line.
+func (p *printer) flush(indent int, line ...int) {
+	for len(p.comments) > 0 && (len(line) == 0 || p.comments[0].Line < line[0]) {
+		p.println(indent, strings.TrimSpace(p.comments[0].Text))
+		p.comments = p.comments[1:]
+	}
+}
+
+// node prints text of a node located at line, preceded by comments before it
+// and followed by comments on the same line.
+func (p *printer) node(indent, line int, text string) {
+	p.flush(indent, line)
+
+	for len(p.comments) > 0 && p.comments[0].Line == line {
+		text += " " + strings.TrimSpace(p.comments[0].Text)
+		p.comments = p.comments[1:]
+	}
+
+	p.println(indent, text)
+}
+
+func (p *printer) println(indent int, text string) {
+	if text != "" {
+		p.buf.WriteString(strings.Repeat("  ", indent))
+	}
+
+	p.buf.WriteString(text + "\n")
+	p.indent, p.empty = indent, text == ""
+}
+
+// blank prints an empty line, unless the last printed line is empty.
+func (p *printer) blank() {
+	if !p.empty && p.buf.Len() > 0 {
+		p.println(0, "")
+	}
+}
+
+func (p *printer) tags(indent int, tags []*Tag) {
+	if len(tags) == 0 {
+		return
+	}
+
+	names := []string{}
+
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+
+	p.node(indent, tags[0].Line, strings.Join(names, " "))
+}
+
+// header prints a keyword line followed by its description, and comments
+// between lines of the description.
+func (p *printer) header(indent int, location Location, keyword, name, description string) {
+	text := keyword + ":"
+
+	if name != "" {
+		text += " " + name
+	}
+
+	p.node(indent, location.Line, text)
+
+	if description == "" {
+		return
+	}
+
+	line, located := p.descriptions[location.Line]
+
+	for _, text := range strings.Split(description, "\n") {
+		// Comments are not part of descriptions, each comment within one moves following lines
+		for located && len(p.comments) > 0 && p.comments[0].Line <= line {
+			if p.comments[0].Line == line {
+				line++
+			}
+
+			p.flush(indent+1, p.comments[0].Line+1)
+		}
+
+		p.buf.WriteString(text + "\n") // As written, including indentation
+		p.empty = false
+		line++
+	}
+}
+
+func (p *printer) feature(feature *Feature) {
+	p.tags(0, feature.Tags)
+	p.header(0, feature.Location, feature.Keyword, feature.Name, feature.Description)
+
+	for _, child := range feature.Children {
+		p.blank()
+
+		switch {
+		case child.Rule != nil:
+			p.rule(child.Rule)
+		case child.Background != nil:
+			p.background(1, child.Background)
+		case child.Scenario != nil:
+			p.scenario(1, child.Scenario)
+		}
+	}
+}
+
+func (p *printer) rule(rule *Rule) {
+	p.tags(1, rule.Tags)
+	p.header(1, rule.Location, rule.Keyword, rule.Name, rule.Description)
+
+	for _, child := range rule.Children {
+		p.blank()
+
+		if child.Background != nil {
+			p.background(2, child.Background)
+		} else {
+			p.scenario(2, child.Scenario)
+		}
+	}
+}
+
+func (p *printer) background(indent int, background *Background) {
+	p.header(indent, background.Location, background.Keyword, background.Name, background.Description)
+	p.steps(indent+1, background.Steps)
+}
+
+func (p *printer) scenario(indent int, scenario *Scenario) {
+	p.tags(indent, scenario.Tags)
+	p.header(indent, scenario.Location, scenario.Keyword, scenario.Name, scenario.Description)
+	p.steps(indent+1, scenario.Steps)
+
+	for _, examples := range scenario.Examples {
+		p.blank()
+		p.tags(indent+1, examples.Tags)
+		p.header(indent+1, examples.Location, examples.Keyword, examples.Name, examples.Description)
+
+		if examples.TableHeader != nil {
+			p.table(indent+2, append([]*TableRow{examples.TableHeader}, examples.TableBody...))
+		}
+	}
+}
+
+func (p *printer) steps(indent int, steps []*Step) {
+	for _, step := range steps {
+		keyword := step.Keyword
+
+		if trimmed := strings.TrimSpace(keyword); trimmed != keyword {
+			keyword = trimmed + " " // Keywords such as "Lorsqu'" are not followed by space
+		}
+
+		p.node(indent, step.Line, keyword+step.Text)
+
+		if step.DocString != nil {
+			p.docString(indent+1, step.DocString)
+		} else if step.DataTable != nil {
+			p.table(indent+1, step.DataTable.Rows)
+		}
+	}
+}
+
+func (p *printer) docString(indent int, docString *DocString) {
+	delimiter := docString.Delimiter
+	escaped := strings.Replace(delimiter, delimiter[:1], `\`+delimiter[:1], -1)
+
+	p.node(indent, docString.Line, delimiter+docString.MediaType)
+
+	for _, line := range strings.Split(docString.Content, "\n") {
+		p.println(indent, strings.Replace(line, delimiter, escaped, -1))
+	}
+
+	p.println(indent, delimiter)
+}
+
+// table prints rows with aligned columns, cell values are escaped.
+func (p *printer) table(indent int, rows []*TableRow) {
+	widths := []int{}
+	escaper := strings.NewReplacer(`\`, `\\`, "|", `\|`, "\n", `\n`)
+
+	for _, row := range rows {
+		for i, cell := range row.Cells {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+
+			if width := len([]rune(escaper.Replace(cell.Value))); width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+
+	for _, row := range rows {
+		cells := []string{}
+
+		for i, cell := range row.Cells {
+			value := escaper.Replace(cell.Value)
+			cells = append(cells, value+strings.Repeat(" ", widths[i]-len([]rune(value))))
+		}
+
+		p.node(indent, row.Line, "| "+strings.Join(cells, " | ")+" |")
+	}
+}
@@ -0,0 +1,74 @@
+package gherkin_test
+
+import (
+	"fmt"
+
+	"gomate.io/gomate/gherkin"
+)
+
+func ExampleFormat() {
+	src := `@billing   @smoke
+Feature: Invoices
+Scenario: Send invoice
+# Customers are created by the background of another feature
+Given a customer
+  When I send an invoice of:
+  |item|amount|
+  | Coffee | 3 |
+`
+
+	formatted, err := gherkin.Format("features/invoices.feature", []byte(src))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Print(string(formatted))
+
+	// Output:
+	// @billing @smoke
+	// Feature: Invoices
+	//
+	//   Scenario: Send invoice
+	//     # Customers are created by the background of another feature
+	//     Given a customer
+	//     When I send an invoice of:
+	//       | item   | amount |
+	//       | Coffee | 3      |
+}
+
+func ExampleFormat_comments() {
+	src := `@billing @smoke # Runs nightly
+Feature: Invoices
+  Invoices are sent monthly,
+  # TODO: weekly invoices
+  unless paid in advance.
+
+@wip   # Not yet deployed
+Scenario: Send reminder
+    Reminders are sent
+    # Twice, see settings
+    until paid.
+Given an unpaid invoice
+`
+
+	formatted, err := gherkin.Format("features/invoices.feature", []byte(src))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Print(string(formatted))
+
+	// Output:
+	// @billing @smoke # Runs nightly
+	// Feature: Invoices
+	//   Invoices are sent monthly,
+	//   # TODO: weekly invoices
+	//   unless paid in advance.
+	//
+	//   @wip # Not yet deployed
+	//   Scenario: Send reminder
+	//     Reminders are sent
+	//     # Twice, see settings
+	//     until paid.
+	//     Given an unpaid invoice
+}
@@ -0,0 +1,139 @@
+package gherkin
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var tagsRegexp = regexp.MustCompile(`^[\t ]*(?P<tags>@[^\s]+(?:[\t ]+@[^\s]+)*)[\t ]*(?P<comment>#.*)?$`)
+var commentRegexp = regexp.MustCompile(`^[\t ]*#`)
+var emptyLineRegexp = regexp.MustCompile(`^[\t ]*$`)
+var tableRowRegexp = regexp.MustCompile(`^[\t ]*\|`)
+var docStringRegexp = regexp.MustCompile("^(?P<indent>[\\t ]*)(?P<delimiter>\"\"\"|```)[\\t ]*(?P<mediatype>[^\\t ]*)[\\t ]*$")
+
+// grammar holds regular expressions matching keyword lines of a Gherkin dialect.
+// Keywords may be indented by any number of spaces and tabs, names and step
+// texts are trimmed from surrounding whitespace.
+type grammar struct {
+	dialect         *Dialect
+	feature         *regexp.Regexp
+	rule            *regexp.Regexp
+	background      *regexp.Regexp
+	scenario        *regexp.Regexp
+	scenarioOutline *regexp.Regexp
+	examples        *regexp.Regexp
+	step            *regexp.Regexp
+
+	stepKeywords map[string]string // English step keywords keyed by keywords of dialect
+}
+
+var grammars = map[string]*grammar{}
+var grammarsMutex sync.Mutex
+
+// grammarFor returns grammar of language, and false if language is unknown.
+func grammarFor(language string) (*grammar, bool) {
+	grammarsMutex.Lock()
+	defer grammarsMutex.Unlock()
+
+	if g, ok := grammars[language]; ok {
+		return g, true
+	}
+
+	dialect, ok := LookupDialect(language)
+	if !ok {
+		return nil, false
+	}
+
+	grammars[language] = newGrammar(dialect)
+
+	return grammars[language], true
+}
+
+func newGrammar(dialect *Dialect) *grammar {
+	keywordLine := func(keywords []string) *regexp.Regexp {
+		return regexp.MustCompile(`^[\t ]*(?P<keyword>` + alternation(keywords) + `):[\t ]*(?P<name>.*?)[\t ]*$`)
+	}
+
+	steps := []string{}
+
+	for _, keywords := range [][]string{dialect.Given, dialect.When, dialect.Then, dialect.And, dialect.But} {
+		steps = append(steps, keywords...)
+	}
+
+	return &grammar{
+		dialect:         dialect,
+		feature:         keywordLine(dialect.Feature),
+		rule:            keywordLine(dialect.Rule),
+		background:      keywordLine(dialect.Background),
+		scenario:        keywordLine(dialect.Scenario),
+		scenarioOutline: keywordLine(dialect.ScenarioOutline),
+		examples:        keywordLine(dialect.Examples),
+		step:            regexp.MustCompile(`^[\t ]*(?P<keyword>` + alternation(steps) + `)[\t ]*(?P<text>.+?)[\t ]*$`),
+		stepKeywords:    dialect.StepKeywords(),
+	}
+}
+
+// alternation returns a regular expression matching any of keywords, longest
+// keywords first. Keywords ending with space are followed by spaces or tabs.
+func alternation(keywords []string) string {
+	unique := map[string]bool{}
+
+	for _, keyword := range keywords {
+		unique[keyword] = true
+	}
+
+	sorted := []string{}
+
+	for keyword := range unique {
+		sorted = append(sorted, keyword)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if len(sorted[i]) != len(sorted[j]) {
+			return len(sorted[i]) > len(sorted[j])
+		}
+
+		return sorted[i] < sorted[j]
+	})
+
+	alternatives := []string{}
+
+	for _, keyword := range sorted {
+		if strings.HasSuffix(keyword, " ") {
+			alternatives = append(alternatives, regexp.QuoteMeta(strings.TrimSpace(keyword))+`[\t ]+`)
+		} else {
+			alternatives = append(alternatives, regexp.QuoteMeta(keyword))
+		}
+	}
+
+	return strings.Join(alternatives, "|")
+}
+
+// isHeader reports if line begins a feature, rule, background, scenario or examples.
+func (g *grammar) isHeader(line string) bool {
+	for _, r := range []*regexp.Regexp{g.feature, g.rule, g.background, g.scenario, g.scenarioOutline, g.examples} {
+		if r.MatchString(line) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// submatches returns named submatches of r in line, nil if line doesn't match.
+func submatches(r *regexp.Regexp, line string) map[string]string {
+	matches := r.FindStringSubmatch(line)
+	if matches == nil {
+		return nil
+	}
+
+	named := map[string]string{}
+
+	for i, name := range r.SubexpNames() {
+		named[name] = matches[i]
+	}
+
+	return named
+}
@@ -0,0 +1,1222 @@
+// Code generated by languages_gen.go from gherkin-languages.json; DO NOT EDIT.
+
+package gherkin
+
+var dialects = map[string]*Dialect{
+	"af": {
+		Language:        "af",
+		Name:            "Afrikaans",
+		Native:          "Afrikaans",
+		Feature:         []string{"Funksie", "Besigheid Behoefte", "Vermoë"},
+		Rule:            []string{"Regel"},
+		Background:      []string{"Agtergrond"},
+		Scenario:        []string{"Voorbeeld", "Situasie"},
+		ScenarioOutline: []string{"Situasie Uiteensetting"},
+		Examples:        []string{"Voorbeelde"},
+		Given:           []string{"* ", "Gegewe "},
+		When:            []string{"* ", "Wanneer "},
+		Then:            []string{"* ", "Dan "},
+		And:             []string{"* ", "En "},
+		But:             []string{"* ", "Maar "},
+	},
+	"am": {
+		Language:        "am",
+		Name:            "Armenian",
+		Native:          "հայերեն",
+		Feature:         []string{"Ֆունկցիոնալություն", "Հատկություն"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Կոնտեքստ"},
+		Scenario:        []string{"Օրինակ", "Սցենար"},
+		ScenarioOutline: []string{"Սցենարի կառուցվացքը"},
+		Examples:        []string{"Օրինակներ"},
+		Given:           []string{"* ", "Դիցուք "},
+		When:            []string{"* ", "Եթե ", "Երբ "},
+		Then:            []string{"* ", "Ապա "},
+		And:             []string{"* ", "Եվ "},
+		But:             []string{"* ", "Բայց "},
+	},
+	"an": {
+		Language:        "an",
+		Name:            "Aragonese",
+		Native:          "Aragonés",
+		Feature:         []string{"Caracteristica"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Antecedents"},
+		Scenario:        []string{"Eixemplo", "Caso"},
+		ScenarioOutline: []string{"Esquema del caso"},
+		Examples:        []string{"Eixemplos"},
+		Given:           []string{"* ", "Dau ", "Dada ", "Daus ", "Dadas "},
+		When:            []string{"* ", "Cuan "},
+		Then:            []string{"* ", "Alavez ", "Allora ", "Antonces "},
+		And:             []string{"* ", "Y ", "E "},
+		But:             []string{"* ", "Pero "},
+	},
+	"ar": {
+		Language:        "ar",
+		Name:            "Arabic",
+		Native:          "العربية",
+		Feature:         []string{"خاصية"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"الخلفية"},
+		Scenario:        []string{"مثال", "سيناريو"},
+		ScenarioOutline: []string{"سيناريو مخطط"},
+		Examples:        []string{"امثلة"},
+		Given:           []string{"* ", "بفرض "},
+		When:            []string{"* ", "متى ", "عندما "},
+		Then:            []string{"* ", "اذاً ", "ثم "},
+		And:             []string{"* ", "و "},
+		But:             []string{"* ", "لكن "},
+	},
+	"ast": {
+		Language:        "ast",
+		Name:            "Asturian",
+		Native:          "asturianu",
+		Feature:         []string{"Carauterística"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Antecedentes"},
+		Scenario:        []string{"Exemplo", "Casu"},
+		ScenarioOutline: []string{"Esbozu del casu"},
+		Examples:        []string{"Exemplos"},
+		Given:           []string{"* ", "Dáu ", "Dada ", "Daos ", "Daes "},
+		When:            []string{"* ", "Cuando "},
+		Then:            []string{"* ", "Entós "},
+		And:             []string{"* ", "Y ", "Ya "},
+		But:             []string{"* ", "Peru "},
+	},
+	"az": {
+		Language:        "az",
+		Name:            "Azerbaijani",
+		Native:          "Azərbaycanca",
+		Feature:         []string{"Özəllik"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Keçmiş", "Kontekst"},
+		Scenario:        []string{"Nümunə", "Ssenari"},
+		ScenarioOutline: []string{"Ssenarinin strukturu"},
+		Examples:        []string{"Nümunələr"},
+		Given:           []string{"* ", "Tutaq ki ", "Verilir "},
+		When:            []string{"* ", "Əgər ", "Nə vaxt ki "},
+		Then:            []string{"* ", "O halda "},
+		And:             []string{"* ", "Və ", "Həm "},
+		But:             []string{"* ", "Amma ", "Ancaq "},
+	},
+	"bg": {
+		Language:        "bg",
+		Name:            "Bulgarian",
+		Native:          "български",
+		Feature:         []string{"Функционалност"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Предистория"},
+		Scenario:        []string{"Пример", "Сценарий"},
+		ScenarioOutline: []string{"Рамка на сценарий"},
+		Examples:        []string{"Примери"},
+		Given:           []string{"* ", "Дадено "},
+		When:            []string{"* ", "Когато "},
+		Then:            []string{"* ", "То "},
+		And:             []string{"* ", "И "},
+		But:             []string{"* ", "Но "},
+	},
+	"bm": {
+		Language:        "bm",
+		Name:            "Malay",
+		Native:          "Bahasa Melayu",
+		Feature:         []string{"Fungsi"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Latar Belakang"},
+		Scenario:        []string{"Senario", "Situasi", "Keadaan"},
+		ScenarioOutline: []string{"Kerangka Senario", "Kerangka Situasi", "Kerangka Keadaan", "Garis Panduan Senario"},
+		Examples:        []string{"Contoh"},
+		Given:           []string{"* ", "Diberi ", "Bagi "},
+		When:            []string{"* ", "Apabila "},
+		Then:            []string{"* ", "Maka ", "Kemudian "},
+		And:             []string{"* ", "Dan "},
+		But:             []string{"* ", "Tetapi ", "Tapi "},
+	},
+	"bs": {
+		Language:        "bs",
+		Name:            "Bosnian",
+		Native:          "Bosanski",
+		Feature:         []string{"Karakteristika"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Pozadina"},
+		Scenario:        []string{"Primjer", "Scenariju", "Scenario"},
+		ScenarioOutline: []string{"Scenariju-obris", "Scenario-outline"},
+		Examples:        []string{"Primjeri"},
+		Given:           []string{"* ", "Dato "},
+		When:            []string{"* ", "Kada "},
+		Then:            []string{"* ", "Zatim "},
+		And:             []string{"* ", "I ", "A "},
+		But:             []string{"* ", "Ali "},
+	},
+	"ca": {
+		Language:        "ca",
+		Name:            "Catalan",
+		Native:          "català",
+		Feature:         []string{"Característica", "Funcionalitat"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Rerefons", "Antecedents"},
+		Scenario:        []string{"Exemple", "Escenari"},
+		ScenarioOutline: []string{"Esquema de l'escenari"},
+		Examples:        []string{"Exemples"},
+		Given:           []string{"* ", "Donat ", "Donada ", "Atès ", "Atesa "},
+		When:            []string{"* ", "Quan "},
+		Then:            []string{"* ", "Aleshores ", "Cal "},
+		And:             []string{"* ", "I "},
+		But:             []string{"* ", "Però "},
+	},
+	"cs": {
+		Language:        "cs",
+		Name:            "Czech",
+		Native:          "Česky",
+		Feature:         []string{"Požadavek"},
+		Rule:            []string{"Pravidlo"},
+		Background:      []string{"Pozadí", "Kontext"},
+		Scenario:        []string{"Příklad", "Scénář"},
+		ScenarioOutline: []string{"Náčrt Scénáře", "Osnova scénáře"},
+		Examples:        []string{"Příklady"},
+		Given:           []string{"* ", "Pokud ", "Za předpokladu "},
+		When:            []string{"* ", "Když "},
+		Then:            []string{"* ", "Pak "},
+		And:             []string{"* ", "A také ", "A "},
+		But:             []string{"* ", "Ale "},
+	},
+	"cy-GB": {
+		Language:        "cy-GB",
+		Name:            "Welsh",
+		Native:          "Cymraeg",
+		Feature:         []string{"Arwedd"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Cefndir"},
+		Scenario:        []string{"Enghraifft", "Scenario"},
+		ScenarioOutline: []string{"Scenario Amlinellol"},
+		Examples:        []string{"Enghreifftiau"},
+		Given:           []string{"* ", "Anrhegedig a "},
+		When:            []string{"* ", "Pryd "},
+		Then:            []string{"* ", "Yna "},
+		And:             []string{"* ", "A "},
+		But:             []string{"* ", "Ond "},
+	},
+	"da": {
+		Language:        "da",
+		Name:            "Danish",
+		Native:          "dansk",
+		Feature:         []string{"Egenskab"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Baggrund"},
+		Scenario:        []string{"Eksempel", "Scenarie"},
+		ScenarioOutline: []string{"Abstrakt Scenario"},
+		Examples:        []string{"Eksempler"},
+		Given:           []string{"* ", "Givet "},
+		When:            []string{"* ", "Når "},
+		Then:            []string{"* ", "Så "},
+		And:             []string{"* ", "Og "},
+		But:             []string{"* ", "Men "},
+	},
+	"de": {
+		Language:        "de",
+		Name:            "German",
+		Native:          "Deutsch",
+		Feature:         []string{"Funktionalität", "Funktion"},
+		Rule:            []string{"Rule", "Regel"},
+		Background:      []string{"Grundlage", "Hintergrund", "Voraussetzungen", "Vorbedingungen"},
+		Scenario:        []string{"Beispiel", "Szenario"},
+		ScenarioOutline: []string{"Szenariogrundriss", "Szenarien"},
+		Examples:        []string{"Beispiele"},
+		Given:           []string{"* ", "Angenommen ", "Gegeben sei ", "Gegeben seien "},
+		When:            []string{"* ", "Wenn "},
+		Then:            []string{"* ", "Dann "},
+		And:             []string{"* ", "Und "},
+		But:             []string{"* ", "Aber "},
+	},
+	"el": {
+		Language:        "el",
+		Name:            "Greek",
+		Native:          "Ελληνικά",
+		Feature:         []string{"Δυνατότητα", "Λειτουργία"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Υπόβαθρο"},
+		Scenario:        []string{"Παράδειγμα", "Σενάριο"},
+		ScenarioOutline: []string{"Περιγραφή Σεναρίου", "Περίγραμμα Σεναρίου"},
+		Examples:        []string{"Παραδείγματα", "Σενάρια"},
+		Given:           []string{"* ", "Δεδομένου "},
+		When:            []string{"* ", "Όταν "},
+		Then:            []string{"* ", "Τότε "},
+		And:             []string{"* ", "Και "},
+		But:             []string{"* ", "Αλλά "},
+	},
+	"em": {
+		Language:        "em",
+		Name:            "Emoji",
+		Native:          "😀",
+		Feature:         []string{"📚"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"💤"},
+		Scenario:        []string{"🥒", "📕"},
+		ScenarioOutline: []string{"📖"},
+		Examples:        []string{"📓"},
+		Given:           []string{"* ", "😐"},
+		When:            []string{"* ", "🎬"},
+		Then:            []string{"* ", "🙏"},
+		And:             []string{"* ", "😂"},
+		But:             []string{"* ", "😔"},
+	},
+	"en": {
+		Language:        "en",
+		Name:            "English",
+		Native:          "English",
+		Feature:         []string{"Feature", "Business Need", "Ability"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Background"},
+		Scenario:        []string{"Example", "Scenario"},
+		ScenarioOutline: []string{"Scenario Outline", "Scenario Template"},
+		Examples:        []string{"Examples", "Scenarios"},
+		Given:           []string{"* ", "Given "},
+		When:            []string{"* ", "When "},
+		Then:            []string{"* ", "Then "},
+		And:             []string{"* ", "And "},
+		But:             []string{"* ", "But "},
+	},
+	"en-Scouse": {
+		Language:        "en-Scouse",
+		Name:            "Scouse",
+		Native:          "Scouse",
+		Feature:         []string{"Feature"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Dis is what went down"},
+		Scenario:        []string{"The thing of it is"},
+		ScenarioOutline: []string{"Wharrimean is"},
+		Examples:        []string{"Examples"},
+		Given:           []string{"* ", "Givun ", "Youse know when youse got "},
+		When:            []string{"* ", "Wun ", "Youse know like when "},
+		Then:            []string{"* ", "Dun ", "Den youse gotta "},
+		And:             []string{"* ", "An "},
+		But:             []string{"* ", "Buh "},
+	},
+	"en-au": {
+		Language:        "en-au",
+		Name:            "Australian",
+		Native:          "Australian",
+		Feature:         []string{"Pretty much"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"First off"},
+		Scenario:        []string{"Awww, look mate"},
+		ScenarioOutline: []string{"Reckon it's like"},
+		Examples:        []string{"You'll wanna"},
+		Given:           []string{"* ", "Y'know "},
+		When:            []string{"* ", "It's just unbelievable "},
+		Then:            []string{"* ", "But at the end of the day I reckon "},
+		And:             []string{"* ", "Too right "},
+		But:             []string{"* ", "Yeah nah "},
+	},
+	"en-lol": {
+		Language:        "en-lol",
+		Name:            "LOLCAT",
+		Native:          "LOLCAT",
+		Feature:         []string{"OH HAI"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"B4"},
+		Scenario:        []string{"MISHUN"},
+		ScenarioOutline: []string{"MISHUN SRSLY"},
+		Examples:        []string{"EXAMPLZ"},
+		Given:           []string{"* ", "I CAN HAZ "},
+		When:            []string{"* ", "WEN "},
+		Then:            []string{"* ", "DEN "},
+		And:             []string{"* ", "AN "},
+		But:             []string{"* ", "BUT "},
+	},
+	"en-old": {
+		Language:        "en-old",
+		Name:            "Old English",
+		Native:          "Englisc",
+		Feature:         []string{"Hwaet", "Hwæt"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Aer", "Ær"},
+		Scenario:        []string{"Swa"},
+		ScenarioOutline: []string{"Swa hwaer swa", "Swa hwær swa"},
+		Examples:        []string{"Se the", "Se þe", "Se ðe"},
+		Given:           []string{"* ", "Thurh ", "Þurh ", "Ðurh "},
+		When:            []string{"* ", "Tha ", "Þa ", "Ða "},
+		Then:            []string{"* ", "Tha ", "Þa ", "Ða ", "Tha the ", "Þa þe ", "Ða ðe "},
+		And:             []string{"* ", "Ond ", "7 "},
+		But:             []string{"* ", "Ac "},
+	},
+	"en-pirate": {
+		Language:        "en-pirate",
+		Name:            "Pirate",
+		Native:          "Pirate",
+		Feature:         []string{"Ahoy matey!"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Yo-ho-ho"},
+		Scenario:        []string{"Heave to"},
+		ScenarioOutline: []string{"Shiver me timbers"},
+		Examples:        []string{"Dead men tell no tales"},
+		Given:           []string{"* ", "Gangway! "},
+		When:            []string{"* ", "Blimey! "},
+		Then:            []string{"* ", "Let go and haul "},
+		And:             []string{"* ", "Aye "},
+		But:             []string{"* ", "Avast! "},
+	},
+	"eo": {
+		Language:        "eo",
+		Name:            "Esperanto",
+		Native:          "Esperanto",
+		Feature:         []string{"Trajto"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Fono"},
+		Scenario:        []string{"Ekzemplo", "Scenaro", "Kazo"},
+		ScenarioOutline: []string{"Konturo de la scenaro", "Skizo", "Kazo-skizo"},
+		Examples:        []string{"Ekzemploj"},
+		Given:           []string{"* ", "Donitaĵo ", "Komence "},
+		When:            []string{"* ", "Se "},
+		Then:            []string{"* ", "Do "},
+		And:             []string{"* ", "Kaj "},
+		But:             []string{"* ", "Sed "},
+	},
+	"es": {
+		Language:        "es",
+		Name:            "Spanish",
+		Native:          "español",
+		Feature:         []string{"Característica", "Necesidad del negocio", "Requisito"},
+		Rule:            []string{"Regla", "Regla de negocio"},
+		Background:      []string{"Antecedentes"},
+		Scenario:        []string{"Ejemplo", "Escenario"},
+		ScenarioOutline: []string{"Esquema del escenario"},
+		Examples:        []string{"Ejemplos"},
+		Given:           []string{"* ", "Dado ", "Dada ", "Dados ", "Dadas "},
+		When:            []string{"* ", "Cuando "},
+		Then:            []string{"* ", "Entonces "},
+		And:             []string{"* ", "Y ", "E "},
+		But:             []string{"* ", "Pero "},
+	},
+	"et": {
+		Language:        "et",
+		Name:            "Estonian",
+		Native:          "eesti keel",
+		Feature:         []string{"Omadus"},
+		Rule:            []string{"Reegel"},
+		Background:      []string{"Taust"},
+		Scenario:        []string{"Juhtum", "Stsenaarium"},
+		ScenarioOutline: []string{"Raamjuhtum", "Raamstsenaarium"},
+		Examples:        []string{"Juhtumid"},
+		Given:           []string{"* ", "Eeldades "},
+		When:            []string{"* ", "Kui "},
+		Then:            []string{"* ", "Siis "},
+		And:             []string{"* ", "Ja "},
+		But:             []string{"* ", "Kuid "},
+	},
+	"fa": {
+		Language:        "fa",
+		Name:            "Persian",
+		Native:          "فارسی",
+		Feature:         []string{"وِیژگی"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"زمینه"},
+		Scenario:        []string{"مثال", "سناریو"},
+		ScenarioOutline: []string{"الگوی سناریو"},
+		Examples:        []string{"نمونه ها"},
+		Given:           []string{"* ", "با فرض "},
+		When:            []string{"* ", "هنگامی "},
+		Then:            []string{"* ", "آنگاه "},
+		And:             []string{"* ", "و "},
+		But:             []string{"* ", "اما "},
+	},
+	"fi": {
+		Language:        "fi",
+		Name:            "Finnish",
+		Native:          "suomi",
+		Feature:         []string{"Ominaisuus"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Tausta"},
+		Scenario:        []string{"Tapaus"},
+		ScenarioOutline: []string{"Tapausaihio"},
+		Examples:        []string{"Tapaukset"},
+		Given:           []string{"* ", "Oletetaan "},
+		When:            []string{"* ", "Kun "},
+		Then:            []string{"* ", "Niin "},
+		And:             []string{"* ", "Ja "},
+		But:             []string{"* ", "Mutta "},
+	},
+	"fr": {
+		Language:        "fr",
+		Name:            "French",
+		Native:          "français",
+		Feature:         []string{"Fonctionnalité"},
+		Rule:            []string{"Règle"},
+		Background:      []string{"Contexte"},
+		Scenario:        []string{"Exemple", "Scénario"},
+		ScenarioOutline: []string{"Plan du scénario", "Plan du Scénario"},
+		Examples:        []string{"Exemples"},
+		Given:           []string{"* ", "Soit ", "Sachant que ", "Sachant qu'", "Sachant ", "Etant donné que ", "Etant donné qu'", "Etant donné ", "Etant donnée ", "Etant donnés ", "Etant données ", "Étant donné que ", "Étant donné qu'", "Étant donné ", "Étant donnée ", "Étant donnés ", "Étant données "},
+		When:            []string{"* ", "Quand ", "Lorsque ", "Lorsqu'"},
+		Then:            []string{"* ", "Alors ", "Donc "},
+		And:             []string{"* ", "Et que ", "Et qu'", "Et "},
+		But:             []string{"* ", "Mais que ", "Mais qu'", "Mais "},
+	},
+	"ga": {
+		Language:        "ga",
+		Name:            "Irish",
+		Native:          "Gaeilge",
+		Feature:         []string{"Gné"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Cúlra"},
+		Scenario:        []string{"Sampla", "Cás"},
+		ScenarioOutline: []string{"Cás Achomair"},
+		Examples:        []string{"Samplaí"},
+		Given:           []string{"* ", "Cuir i gcás go", "Cuir i gcás nach", "Cuir i gcás gur", "Cuir i gcás nár"},
+		When:            []string{"* ", "Nuair a", "Nuair nach", "Nuair ba", "Nuair nár"},
+		Then:            []string{"* ", "Ansin"},
+		And:             []string{"* ", "Agus"},
+		But:             []string{"* ", "Ach"},
+	},
+	"gj": {
+		Language:        "gj",
+		Name:            "Gujarati",
+		Native:          "ગુજરાતી",
+		Feature:         []string{"લક્ષણ", "વ્યાપાર જરૂર", "ક્ષમતા"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"બેકગ્રાઉન્ડ"},
+		Scenario:        []string{"ઉદાહરણ", "સ્થિતિ"},
+		ScenarioOutline: []string{"પરિદ્દશ્ય રૂપરેખા", "પરિદ્દશ્ય ઢાંચો"},
+		Examples:        []string{"ઉદાહરણો"},
+		Given:           []string{"* ", "આપેલ છે "},
+		When:            []string{"* ", "ક્યારે "},
+		Then:            []string{"* ", "પછી "},
+		And:             []string{"* ", "અને "},
+		But:             []string{"* ", "પણ "},
+	},
+	"gl": {
+		Language:        "gl",
+		Name:            "Galician",
+		Native:          "galego",
+		Feature:         []string{"Característica"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Contexto"},
+		Scenario:        []string{"Exemplo", "Escenario"},
+		ScenarioOutline: []string{"Esbozo do escenario"},
+		Examples:        []string{"Exemplos"},
+		Given:           []string{"* ", "Dado ", "Dada ", "Dados ", "Dadas "},
+		When:            []string{"* ", "Cando "},
+		Then:            []string{"* ", "Entón ", "Logo "},
+		And:             []string{"* ", "E "},
+		But:             []string{"* ", "Mais ", "Pero "},
+	},
+	"he": {
+		Language:        "he",
+		Name:            "Hebrew",
+		Native:          "עברית",
+		Feature:         []string{"תכונה"},
+		Rule:            []string{"כלל"},
+		Background:      []string{"רקע"},
+		Scenario:        []string{"דוגמא", "תרחיש"},
+		ScenarioOutline: []string{"תבנית תרחיש"},
+		Examples:        []string{"דוגמאות"},
+		Given:           []string{"* ", "בהינתן "},
+		When:            []string{"* ", "כאשר "},
+		Then:            []string{"* ", "אז ", "אזי "},
+		And:             []string{"* ", "וגם "},
+		But:             []string{"* ", "אבל "},
+	},
+	"hi": {
+		Language:        "hi",
+		Name:            "Hindi",
+		Native:          "हिंदी",
+		Feature:         []string{"रूप लेख"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"पृष्ठभूमि"},
+		Scenario:        []string{"परिदृश्य"},
+		ScenarioOutline: []string{"परिदृश्य रूपरेखा"},
+		Examples:        []string{"उदाहरण"},
+		Given:           []string{"* ", "अगर ", "यदि ", "चूंकि "},
+		When:            []string{"* ", "जब ", "कदा "},
+		Then:            []string{"* ", "तब ", "तदा "},
+		And:             []string{"* ", "और ", "तथा "},
+		But:             []string{"* ", "पर ", "परन्तु ", "किन्तु "},
+	},
+	"hr": {
+		Language:        "hr",
+		Name:            "Croatian",
+		Native:          "hrvatski",
+		Feature:         []string{"Osobina", "Mogućnost", "Mogucnost"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Pozadina"},
+		Scenario:        []string{"Primjer", "Scenarij"},
+		ScenarioOutline: []string{"Skica", "Koncept"},
+		Examples:        []string{"Primjeri", "Scenariji"},
+		Given:           []string{"* ", "Zadan ", "Zadani ", "Zadano ", "Ukoliko "},
+		When:            []string{"* ", "Kada ", "Kad "},
+		Then:            []string{"* ", "Onda "},
+		And:             []string{"* ", "I "},
+		But:             []string{"* ", "Ali "},
+	},
+	"ht": {
+		Language:        "ht",
+		Name:            "Creole",
+		Native:          "kreyòl",
+		Feature:         []string{"Karakteristik", "Mak", "Fonksyonalite"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Kontèks", "Istorik"},
+		Scenario:        []string{"Senaryo"},
+		ScenarioOutline: []string{"Plan senaryo", "Plan Senaryo", "Senaryo deskripsyon", "Senaryo Deskripsyon", "Dyagram senaryo", "Dyagram Senaryo"},
+		Examples:        []string{"Egzanp"},
+		Given:           []string{"* ", "Sipoze ", "Sipoze ke ", "Sipoze Ke "},
+		When:            []string{"* ", "Lè ", "Le "},
+		Then:            []string{"* ", "Lè sa a ", "Le sa a "},
+		And:             []string{"* ", "Ak ", "Epi ", "E "},
+		But:             []string{"* ", "Men "},
+	},
+	"hu": {
+		Language:        "hu",
+		Name:            "Hungarian",
+		Native:          "magyar",
+		Feature:         []string{"Jellemző"},
+		Rule:            []string{"Szabály"},
+		Background:      []string{"Háttér"},
+		Scenario:        []string{"Példa", "Forgatókönyv"},
+		ScenarioOutline: []string{"Forgatókönyv vázlat"},
+		Examples:        []string{"Példák"},
+		Given:           []string{"* ", "Amennyiben ", "Adott "},
+		When:            []string{"* ", "Majd ", "Ha ", "Amikor "},
+		Then:            []string{"* ", "Akkor "},
+		And:             []string{"* ", "És "},
+		But:             []string{"* ", "De "},
+	},
+	"id": {
+		Language:        "id",
+		Name:            "Indonesian",
+		Native:          "Bahasa Indonesia",
+		Feature:         []string{"Fitur"},
+		Rule:            []string{"Rule", "Aturan"},
+		Background:      []string{"Dasar", "Latar Belakang"},
+		Scenario:        []string{"Skenario"},
+		ScenarioOutline: []string{"Skenario konsep", "Garis-Besar Skenario"},
+		Examples:        []string{"Contoh", "Misal"},
+		Given:           []string{"* ", "Dengan ", "Diketahui ", "Diasumsikan ", "Bila ", "Jika "},
+		When:            []string{"* ", "Ketika "},
+		Then:            []string{"* ", "Maka ", "Kemudian "},
+		And:             []string{"* ", "Dan "},
+		But:             []string{"* ", "Tapi ", "Tetapi "},
+	},
+	"is": {
+		Language:        "is",
+		Name:            "Icelandic",
+		Native:          "Íslenska",
+		Feature:         []string{"Eiginleiki"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Bakgrunnur"},
+		Scenario:        []string{"Atburðarás"},
+		ScenarioOutline: []string{"Lýsing Atburðarásar", "Lýsing Dæma"},
+		Examples:        []string{"Dæmi", "Atburðarásir"},
+		Given:           []string{"* ", "Ef "},
+		When:            []string{"* ", "Þegar "},
+		Then:            []string{"* ", "Þá "},
+		And:             []string{"* ", "Og "},
+		But:             []string{"* ", "En "},
+	},
+	"it": {
+		Language:        "it",
+		Name:            "Italian",
+		Native:          "italiano",
+		Feature:         []string{"Funzionalità", "Esigenza di Business", "Abilità"},
+		Rule:            []string{"Regola"},
+		Background:      []string{"Contesto"},
+		Scenario:        []string{"Esempio", "Scenario"},
+		ScenarioOutline: []string{"Schema dello scenario"},
+		Examples:        []string{"Esempi"},
+		Given:           []string{"* ", "Dato ", "Data ", "Dati ", "Date "},
+		When:            []string{"* ", "Quando "},
+		Then:            []string{"* ", "Allora "},
+		And:             []string{"* ", "E "},
+		But:             []string{"* ", "Ma "},
+	},
+	"ja": {
+		Language:        "ja",
+		Name:            "Japanese",
+		Native:          "日本語",
+		Feature:         []string{"フィーチャ", "機能"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"背景"},
+		Scenario:        []string{"シナリオ"},
+		ScenarioOutline: []string{"シナリオアウトライン", "シナリオテンプレート", "テンプレ", "シナリオテンプレ"},
+		Examples:        []string{"例", "サンプル"},
+		Given:           []string{"* ", "前提"},
+		When:            []string{"* ", "もし"},
+		Then:            []string{"* ", "ならば"},
+		And:             []string{"* ", "かつ"},
+		But:             []string{"* ", "しかし", "但し", "ただし"},
+	},
+	"jv": {
+		Language:        "jv",
+		Name:            "Javanese",
+		Native:          "Basa Jawa",
+		Feature:         []string{"Fitur"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Dasar"},
+		Scenario:        []string{"Skenario"},
+		ScenarioOutline: []string{"Konsep skenario"},
+		Examples:        []string{"Conto", "Contone"},
+		Given:           []string{"* ", "Nalika ", "Nalikaning "},
+		When:            []string{"* ", "Manawa ", "Menawa "},
+		Then:            []string{"* ", "Njuk ", "Banjur "},
+		And:             []string{"* ", "Lan "},
+		But:             []string{"* ", "Tapi ", "Nanging ", "Ananging "},
+	},
+	"ka": {
+		Language:        "ka",
+		Name:            "Georgian",
+		Native:          "ქართველი",
+		Feature:         []string{"თვისება"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"კონტექსტი"},
+		Scenario:        []string{"მაგალითად", "სცენარის"},
+		ScenarioOutline: []string{"სცენარის ნიმუში"},
+		Examples:        []string{"მაგალითები"},
+		Given:           []string{"* ", "მოცემული"},
+		When:            []string{"* ", "როდესაც"},
+		Then:            []string{"* ", "მაშინ"},
+		And:             []string{"* ", "და"},
+		But:             []string{"* ", "მაგ\u00adრამ"},
+	},
+	"kn": {
+		Language:        "kn",
+		Name:            "Kannada",
+		Native:          "ಕನ್ನಡ",
+		Feature:         []string{"ಹೆಚ್ಚಳ"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"ಹಿನ್ನೆಲೆ"},
+		Scenario:        []string{"ಉದಾಹರಣೆ", "ಕಥಾಸಾರಾಂಶ"},
+		ScenarioOutline: []string{"ವಿವರಣೆ"},
+		Examples:        []string{"ಉದಾಹರಣೆಗಳು"},
+		Given:           []string{"* ", "ನೀಡಿದ "},
+		When:            []string{"* ", "ಸ್ಥಿತಿಯನ್ನು "},
+		Then:            []string{"* ", "ನಂತರ "},
+		And:             []string{"* ", "ಮತ್ತು "},
+		But:             []string{"* ", "ಆದರೆ "},
+	},
+	"ko": {
+		Language:        "ko",
+		Name:            "Korean",
+		Native:          "한국어",
+		Feature:         []string{"기능"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"배경"},
+		Scenario:        []string{"시나리오"},
+		ScenarioOutline: []string{"시나리오 개요"},
+		Examples:        []string{"예"},
+		Given:           []string{"* ", "조건", "먼저"},
+		When:            []string{"* ", "만일", "만약"},
+		Then:            []string{"* ", "그러면"},
+		And:             []string{"* ", "그리고"},
+		But:             []string{"* ", "하지만", "단"},
+	},
+	"lt": {
+		Language:        "lt",
+		Name:            "Lithuanian",
+		Native:          "lietuvių kalba",
+		Feature:         []string{"Savybė"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Kontekstas"},
+		Scenario:        []string{"Pavyzdys", "Scenarijus"},
+		ScenarioOutline: []string{"Scenarijaus šablonas"},
+		Examples:        []string{"Pavyzdžiai", "Scenarijai", "Variantai"},
+		Given:           []string{"* ", "Duota "},
+		When:            []string{"* ", "Kai "},
+		Then:            []string{"* ", "Tada "},
+		And:             []string{"* ", "Ir "},
+		But:             []string{"* ", "Bet "},
+	},
+	"lu": {
+		Language:        "lu",
+		Name:            "Luxemburgish",
+		Native:          "Lëtzebuergesch",
+		Feature:         []string{"Funktionalitéit"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Hannergrond"},
+		Scenario:        []string{"Beispill", "Szenario"},
+		ScenarioOutline: []string{"Plang vum Szenario"},
+		Examples:        []string{"Beispiller"},
+		Given:           []string{"* ", "ugeholl "},
+		When:            []string{"* ", "wann "},
+		Then:            []string{"* ", "dann "},
+		And:             []string{"* ", "an ", "a "},
+		But:             []string{"* ", "awer ", "mä "},
+	},
+	"lv": {
+		Language:        "lv",
+		Name:            "Latvian",
+		Native:          "latviešu",
+		Feature:         []string{"Funkcionalitāte", "Fīča"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Konteksts", "Situācija"},
+		Scenario:        []string{"Piemērs", "Scenārijs"},
+		ScenarioOutline: []string{"Scenārijs pēc parauga"},
+		Examples:        []string{"Piemēri", "Paraugs"},
+		Given:           []string{"* ", "Kad "},
+		When:            []string{"* ", "Ja "},
+		Then:            []string{"* ", "Tad "},
+		And:             []string{"* ", "Un "},
+		But:             []string{"* ", "Bet "},
+	},
+	"mk-Cyrl": {
+		Language:        "mk-Cyrl",
+		Name:            "Macedonian",
+		Native:          "Македонски",
+		Feature:         []string{"Функционалност", "Бизнис потреба", "Можност"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Контекст", "Содржина"},
+		Scenario:        []string{"Пример", "Сценарио", "На пример"},
+		ScenarioOutline: []string{"Преглед на сценарија", "Скица", "Концепт"},
+		Examples:        []string{"Примери", "Сценарија"},
+		Given:           []string{"* ", "Дадено ", "Дадена "},
+		When:            []string{"* ", "Кога "},
+		Then:            []string{"* ", "Тогаш "},
+		And:             []string{"* ", "И "},
+		But:             []string{"* ", "Но "},
+	},
+	"mk-Latn": {
+		Language:        "mk-Latn",
+		Name:            "Macedonian (Latin)",
+		Native:          "Makedonski (Latinica)",
+		Feature:         []string{"Funkcionalnost", "Biznis potreba", "Mozhnost"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Kontekst", "Sodrzhina"},
+		Scenario:        []string{"Scenario", "Na primer"},
+		ScenarioOutline: []string{"Pregled na scenarija", "Skica", "Koncept"},
+		Examples:        []string{"Primeri", "Scenaria"},
+		Given:           []string{"* ", "Dadeno ", "Dadena "},
+		When:            []string{"* ", "Koga "},
+		Then:            []string{"* ", "Togash "},
+		And:             []string{"* ", "I "},
+		But:             []string{"* ", "No "},
+	},
+	"mn": {
+		Language:        "mn",
+		Name:            "Mongolian",
+		Native:          "монгол",
+		Feature:         []string{"Функц", "Функционал"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Агуулга"},
+		Scenario:        []string{"Сценар"},
+		ScenarioOutline: []string{"Сценарын төлөвлөгөө"},
+		Examples:        []string{"Тухайлбал"},
+		Given:           []string{"* ", "Өгөгдсөн нь ", "Анх "},
+		When:            []string{"* ", "Хэрэв "},
+		Then:            []string{"* ", "Тэгэхэд ", "Үүний дараа "},
+		And:             []string{"* ", "Мөн ", "Тэгээд "},
+		But:             []string{"* ", "Гэхдээ ", "Харин "},
+	},
+	"mr": {
+		Language:        "mr",
+		Name:            "Marathi",
+		Native:          "मराठी",
+		Feature:         []string{"वैशिष्ट्य", "सुविधा"},
+		Rule:            []string{"नियम"},
+		Background:      []string{"पार्श्वभूमी"},
+		Scenario:        []string{"परिदृश्य"},
+		ScenarioOutline: []string{"परिदृश्य रूपरेखा"},
+		Examples:        []string{"उदाहरण"},
+		Given:           []string{"* ", "जर", "दिलेल्या प्रमाणे "},
+		When:            []string{"* ", "जेव्हा "},
+		Then:            []string{"* ", "मग ", "तेव्हा "},
+		And:             []string{"* ", "आणि ", "तसेच "},
+		But:             []string{"* ", "पण ", "परंतु "},
+	},
+	"ne": {
+		Language:        "ne",
+		Name:            "Nepali",
+		Native:          "नेपाली",
+		Feature:         []string{"सुविधा", "विशेषता"},
+		Rule:            []string{"नियम"},
+		Background:      []string{"पृष्ठभूमी"},
+		Scenario:        []string{"परिदृश्य"},
+		ScenarioOutline: []string{"परिदृश्य रूपरेखा"},
+		Examples:        []string{"उदाहरण", "उदाहरणहरु"},
+		Given:           []string{"* ", "दिइएको ", "दिएको ", "यदि "},
+		When:            []string{"* ", "जब "},
+		Then:            []string{"* ", "त्यसपछि ", "अनी "},
+		And:             []string{"* ", "र ", "अनी "},
+		But:             []string{"* ", "तर "},
+	},
+	"nl": {
+		Language:        "nl",
+		Name:            "Dutch",
+		Native:          "Nederlands",
+		Feature:         []string{"Functionaliteit"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Achtergrond"},
+		Scenario:        []string{"Voorbeeld", "Scenario"},
+		ScenarioOutline: []string{"Abstract Scenario"},
+		Examples:        []string{"Voorbeelden"},
+		Given:           []string{"* ", "Gegeven ", "Stel "},
+		When:            []string{"* ", "Als ", "Wanneer "},
+		Then:            []string{"* ", "Dan "},
+		And:             []string{"* ", "En "},
+		But:             []string{"* ", "Maar "},
+	},
+	"no": {
+		Language:        "no",
+		Name:            "Norwegian",
+		Native:          "norsk",
+		Feature:         []string{"Egenskap"},
+		Rule:            []string{"Regel"},
+		Background:      []string{"Bakgrunn"},
+		Scenario:        []string{"Eksempel", "Scenario"},
+		ScenarioOutline: []string{"Scenariomal", "Abstrakt Scenario"},
+		Examples:        []string{"Eksempler"},
+		Given:           []string{"* ", "Gitt "},
+		When:            []string{"* ", "Når "},
+		Then:            []string{"* ", "Så "},
+		And:             []string{"* ", "Og "},
+		But:             []string{"* ", "Men "},
+	},
+	"pa": {
+		Language:        "pa",
+		Name:            "Panjabi",
+		Native:          "ਪੰਜਾਬੀ",
+		Feature:         []string{"ਖਾਸੀਅਤ", "ਮੁਹਾਂਦਰਾ", "ਨਕਸ਼ ਨੁਹਾਰ"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"ਪਿਛੋਕੜ"},
+		Scenario:        []string{"ਉਦਾਹਰਨ", "ਪਟਕਥਾ"},
+		ScenarioOutline: []string{"ਪਟਕਥਾ ਢਾਂਚਾ", "ਪਟਕਥਾ ਰੂਪ ਰੇਖਾ"},
+		Examples:        []string{"ਉਦਾਹਰਨਾਂ"},
+		Given:           []string{"* ", "ਜੇਕਰ ", "ਜਿਵੇਂ ਕਿ "},
+		When:            []string{"* ", "ਜਦੋਂ "},
+		Then:            []string{"* ", "ਤਦ "},
+		And:             []string{"* ", "ਅਤੇ "},
+		But:             []string{"* ", "ਪਰ "},
+	},
+	"pl": {
+		Language:        "pl",
+		Name:            "Polish",
+		Native:          "polski",
+		Feature:         []string{"Właściwość", "Funkcja", "Aspekt", "Potrzeba biznesowa"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Założenia"},
+		Scenario:        []string{"Przykład", "Scenariusz"},
+		ScenarioOutline: []string{"Szablon scenariusza"},
+		Examples:        []string{"Przykłady"},
+		Given:           []string{"* ", "Zakładając ", "Mając ", "Zakładając, że "},
+		When:            []string{"* ", "Jeżeli ", "Jeśli ", "Gdy ", "Kiedy "},
+		Then:            []string{"* ", "Wtedy "},
+		And:             []string{"* ", "Oraz ", "I "},
+		But:             []string{"* ", "Ale "},
+	},
+	"pt": {
+		Language:        "pt",
+		Name:            "Portuguese",
+		Native:          "português",
+		Feature:         []string{"Funcionalidade", "Característica", "Caracteristica"},
+		Rule:            []string{"Regra"},
+		Background:      []string{"Contexto", "Cenário de Fundo", "Cenario de Fundo", "Fundo"},
+		Scenario:        []string{"Exemplo", "Cenário", "Cenario"},
+		ScenarioOutline: []string{"Esquema do Cenário", "Esquema do Cenario", "Delineação do Cenário", "Delineacao do Cenario"},
+		Examples:        []string{"Exemplos", "Cenários", "Cenarios"},
+		Given:           []string{"* ", "Dado ", "Dada ", "Dados ", "Dadas "},
+		When:            []string{"* ", "Quando "},
+		Then:            []string{"* ", "Então ", "Entao "},
+		And:             []string{"* ", "E "},
+		But:             []string{"* ", "Mas "},
+	},
+	"ro": {
+		Language:        "ro",
+		Name:            "Romanian",
+		Native:          "română",
+		Feature:         []string{"Functionalitate", "Funcționalitate", "Funcţionalitate"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Context"},
+		Scenario:        []string{"Exemplu", "Scenariu"},
+		ScenarioOutline: []string{"Structura scenariu", "Structură scenariu"},
+		Examples:        []string{"Exemple"},
+		Given:           []string{"* ", "Date fiind ", "Dat fiind ", "Dată fiind", "Dati fiind ", "Dați fiind ", "Daţi fiind "},
+		When:            []string{"* ", "Cand ", "Când "},
+		Then:            []string{"* ", "Atunci "},
+		And:             []string{"* ", "Si ", "Și ", "Şi "},
+		But:             []string{"* ", "Dar "},
+	},
+	"ru": {
+		Language:        "ru",
+		Name:            "Russian",
+		Native:          "русский",
+		Feature:         []string{"Функция", "Функциональность", "Функционал", "Свойство"},
+		Rule:            []string{"Правило"},
+		Background:      []string{"Предыстория", "Контекст"},
+		Scenario:        []string{"Пример", "Сценарий"},
+		ScenarioOutline: []string{"Структура сценария", "Шаблон сценария"},
+		Examples:        []string{"Примеры"},
+		Given:           []string{"* ", "Допустим ", "Дано ", "Пусть "},
+		When:            []string{"* ", "Когда ", "Если "},
+		Then:            []string{"* ", "То ", "Затем ", "Тогда "},
+		And:             []string{"* ", "И ", "К тому же ", "Также "},
+		But:             []string{"* ", "Но ", "А ", "Иначе "},
+	},
+	"sk": {
+		Language:        "sk",
+		Name:            "Slovak",
+		Native:          "Slovensky",
+		Feature:         []string{"Požiadavka", "Funkcia", "Vlastnosť"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Pozadie"},
+		Scenario:        []string{"Príklad", "Scenár"},
+		ScenarioOutline: []string{"Náčrt Scenáru", "Náčrt Scenára", "Osnova Scenára"},
+		Examples:        []string{"Príklady"},
+		Given:           []string{"* ", "Pokiaľ ", "Za predpokladu "},
+		When:            []string{"* ", "Keď ", "Ak "},
+		Then:            []string{"* ", "Tak ", "Potom "},
+		And:             []string{"* ", "A ", "A tiež ", "A taktiež ", "A zároveň "},
+		But:             []string{"* ", "Ale "},
+	},
+	"sl": {
+		Language:        "sl",
+		Name:            "Slovenian",
+		Native:          "Slovenski",
+		Feature:         []string{"Funkcionalnost", "Funkcija", "Možnosti", "Moznosti", "Lastnost", "Značilnost"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Kontekst", "Osnova", "Ozadje"},
+		Scenario:        []string{"Primer", "Scenarij"},
+		ScenarioOutline: []string{"Struktura scenarija", "Skica", "Koncept", "Oris scenarija", "Osnutek"},
+		Examples:        []string{"Primeri", "Scenariji"},
+		Given:           []string{"Dano ", "Podano ", "Zaradi ", "Privzeto "},
+		When:            []string{"Ko ", "Ce ", "Če ", "Kadar "},
+		Then:            []string{"Nato ", "Potem ", "Takrat "},
+		And:             []string{"In ", "Ter "},
+		But:             []string{"Toda ", "Ampak ", "Vendar "},
+	},
+	"sr-Cyrl": {
+		Language:        "sr-Cyrl",
+		Name:            "Serbian",
+		Native:          "Српски",
+		Feature:         []string{"Функционалност", "Могућност", "Особина"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Контекст", "Основа", "Позадина"},
+		Scenario:        []string{"Пример", "Сценарио", "Пример"},
+		ScenarioOutline: []string{"Структура сценарија", "Скица", "Концепт"},
+		Examples:        []string{"Примери", "Сценарији"},
+		Given:           []string{"* ", "За дато ", "За дате ", "За дати "},
+		When:            []string{"* ", "Када ", "Кад "},
+		Then:            []string{"* ", "Онда "},
+		And:             []string{"* ", "И "},
+		But:             []string{"* ", "Али "},
+	},
+	"sr-Latn": {
+		Language:        "sr-Latn",
+		Name:            "Serbian (Latin)",
+		Native:          "Srpski (Latinica)",
+		Feature:         []string{"Funkcionalnost", "Mogućnost", "Mogucnost", "Osobina"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Kontekst", "Osnova", "Pozadina"},
+		Scenario:        []string{"Scenario", "Primer"},
+		ScenarioOutline: []string{"Struktura scenarija", "Skica", "Koncept"},
+		Examples:        []string{"Primeri", "Scenariji"},
+		Given:           []string{"* ", "Za dato ", "Za date ", "Za dati "},
+		When:            []string{"* ", "Kada ", "Kad "},
+		Then:            []string{"* ", "Onda "},
+		And:             []string{"* ", "I "},
+		But:             []string{"* ", "Ali "},
+	},
+	"sv": {
+		Language:        "sv",
+		Name:            "Swedish",
+		Native:          "Svenska",
+		Feature:         []string{"Egenskap"},
+		Rule:            []string{"Regel"},
+		Background:      []string{"Bakgrund"},
+		Scenario:        []string{"Scenario"},
+		ScenarioOutline: []string{"Abstrakt Scenario", "Scenariomall"},
+		Examples:        []string{"Exempel"},
+		Given:           []string{"* ", "Givet "},
+		When:            []string{"* ", "När "},
+		Then:            []string{"* ", "Så "},
+		And:             []string{"* ", "Och "},
+		But:             []string{"* ", "Men "},
+	},
+	"ta": {
+		Language:        "ta",
+		Name:            "Tamil",
+		Native:          "தமிழ்",
+		Feature:         []string{"அம்சம்", "வணிக தேவை", "திறன்"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"பின்னணி"},
+		Scenario:        []string{"உதாரணமாக", "காட்சி"},
+		ScenarioOutline: []string{"காட்சி சுருக்கம்", "காட்சி வார்ப்புரு"},
+		Examples:        []string{"எடுத்துக்காட்டுகள்", "காட்சிகள்", "நிலைமைகளில்"},
+		Given:           []string{"* ", "கொடுக்கப்பட்ட "},
+		When:            []string{"* ", "எப்போது "},
+		Then:            []string{"* ", "அப்பொழுது "},
+		And:             []string{"* ", "மேலும்  ", "மற்றும் "},
+		But:             []string{"* ", "ஆனால்  "},
+	},
+	"te": {
+		Language:        "te",
+		Name:            "Telugu",
+		Native:          "తెలుగు",
+		Feature:         []string{"గుణము"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"నేపథ్యం"},
+		Scenario:        []string{"ఉదాహరణ", "సన్నివేశం"},
+		ScenarioOutline: []string{"కథనం"},
+		Examples:        []string{"ఉదాహరణలు"},
+		Given:           []string{"* ", "చెప్పబడినది "},
+		When:            []string{"* ", "ఈ పరిస్థితిలో "},
+		Then:            []string{"* ", "అప్పుడు "},
+		And:             []string{"* ", "మరియు "},
+		But:             []string{"* ", "కాని "},
+	},
+	"th": {
+		Language:        "th",
+		Name:            "Thai",
+		Native:          "ไทย",
+		Feature:         []string{"โครงหลัก", "ความต้องการทางธุรกิจ", "ความสามารถ"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"แนวคิด"},
+		Scenario:        []string{"เหตุการณ์"},
+		ScenarioOutline: []string{"สรุปเหตุการณ์", "โครงสร้างของเหตุการณ์"},
+		Examples:        []string{"ชุดของตัวอย่าง", "ชุดของเหตุการณ์"},
+		Given:           []string{"* ", "กำหนดให้ "},
+		When:            []string{"* ", "เมื่อ "},
+		Then:            []string{"* ", "ดังนั้น "},
+		And:             []string{"* ", "และ "},
+		But:             []string{"* ", "แต่ "},
+	},
+	"tlh": {
+		Language:        "tlh",
+		Name:            "Klingon",
+		Native:          "tlhIngan",
+		Feature:         []string{"Qap", "Qu'meH 'ut", "perbogh", "poQbogh malja'", "laH"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"mo'"},
+		Scenario:        []string{"lut"},
+		ScenarioOutline: []string{"lut chovnatlh"},
+		Examples:        []string{"ghantoH", "lutmey"},
+		Given:           []string{"* ", "ghu' noblu' ", "DaH ghu' bejlu' "},
+		When:            []string{"* ", "qaSDI' "},
+		Then:            []string{"* ", "vaj "},
+		And:             []string{"* ", "'ej ", "latlh "},
+		But:             []string{"* ", "'ach ", "'a "},
+	},
+	"tr": {
+		Language:        "tr",
+		Name:            "Turkish",
+		Native:          "Türkçe",
+		Feature:         []string{"Özellik"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Geçmiş"},
+		Scenario:        []string{"Örnek", "Senaryo"},
+		ScenarioOutline: []string{"Senaryo taslağı"},
+		Examples:        []string{"Örnekler"},
+		Given:           []string{"* ", "Diyelim ki "},
+		When:            []string{"* ", "Eğer ki "},
+		Then:            []string{"* ", "O zaman "},
+		And:             []string{"* ", "Ve "},
+		But:             []string{"* ", "Fakat ", "Ama "},
+	},
+	"tt": {
+		Language:        "tt",
+		Name:            "Tatar",
+		Native:          "Татарча",
+		Feature:         []string{"Мөмкинлек", "Үзенчәлеклелек"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Кереш"},
+		Scenario:        []string{"Сценарий"},
+		ScenarioOutline: []string{"Сценарийның төзелеше"},
+		Examples:        []string{"Үрнәкләр", "Мисаллар"},
+		Given:           []string{"* ", "Әйтик "},
+		When:            []string{"* ", "Әгәр "},
+		Then:            []string{"* ", "Нәтиҗәдә "},
+		And:             []string{"* ", "Һәм ", "Вә "},
+		But:             []string{"* ", "Ләкин ", "Әмма "},
+	},
+	"uk": {
+		Language:        "uk",
+		Name:            "Ukrainian",
+		Native:          "Українська",
+		Feature:         []string{"Функціонал"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Передумова"},
+		Scenario:        []string{"Приклад", "Сценарій"},
+		ScenarioOutline: []string{"Структура сценарію"},
+		Examples:        []string{"Приклади"},
+		Given:           []string{"* ", "Припустимо ", "Припустимо, що ", "Нехай ", "Дано "},
+		When:            []string{"* ", "Якщо ", "Коли "},
+		Then:            []string{"* ", "То ", "Тоді "},
+		And:             []string{"* ", "І ", "А також ", "Та "},
+		But:             []string{"* ", "Але "},
+	},
+	"ur": {
+		Language:        "ur",
+		Name:            "Urdu",
+		Native:          "اردو",
+		Feature:         []string{"صلاحیت", "کاروبار کی ضرورت", "خصوصیت"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"پس منظر"},
+		Scenario:        []string{"منظرنامہ"},
+		ScenarioOutline: []string{"منظر نامے کا خاکہ"},
+		Examples:        []string{"مثالیں"},
+		Given:           []string{"* ", "اگر ", "بالفرض ", "فرض کیا "},
+		When:            []string{"* ", "جب "},
+		Then:            []string{"* ", "پھر ", "تب "},
+		And:             []string{"* ", "اور "},
+		But:             []string{"* ", "لیکن "},
+	},
+	"uz": {
+		Language:        "uz",
+		Name:            "Uzbek",
+		Native:          "Узбекча",
+		Feature:         []string{"Функционал"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Тарих"},
+		Scenario:        []string{"Сценарий"},
+		ScenarioOutline: []string{"Сценарий структураси"},
+		Examples:        []string{"Мисоллар"},
+		Given:           []string{"* ", "Агар "},
+		When:            []string{"* ", "Агар "},
+		Then:            []string{"* ", "Унда "},
+		And:             []string{"* ", "Ва "},
+		But:             []string{"* ", "Лекин ", "Бирок ", "Аммо "},
+	},
+	"vi": {
+		Language:        "vi",
+		Name:            "Vietnamese",
+		Native:          "Tiếng Việt",
+		Feature:         []string{"Tính năng"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"Bối cảnh"},
+		Scenario:        []string{"Tình huống", "Kịch bản"},
+		ScenarioOutline: []string{"Khung tình huống", "Khung kịch bản"},
+		Examples:        []string{"Dữ liệu"},
+		Given:           []string{"* ", "Biết ", "Cho "},
+		When:            []string{"* ", "Khi "},
+		Then:            []string{"* ", "Thì "},
+		And:             []string{"* ", "Và "},
+		But:             []string{"* ", "Nhưng "},
+	},
+	"zh-CN": {
+		Language:        "zh-CN",
+		Name:            "Chinese simplified",
+		Native:          "简体中文",
+		Feature:         []string{"功能"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"背景"},
+		Scenario:        []string{"场景", "剧本"},
+		ScenarioOutline: []string{"场景大纲", "剧本大纲"},
+		Examples:        []string{"例子"},
+		Given:           []string{"* ", "假如", "假设", "假定"},
+		When:            []string{"* ", "当"},
+		Then:            []string{"* ", "那么"},
+		And:             []string{"* ", "而且", "并且", "同时"},
+		But:             []string{"* ", "但是"},
+	},
+	"zh-TW": {
+		Language:        "zh-TW",
+		Name:            "Chinese traditional",
+		Native:          "繁體中文",
+		Feature:         []string{"功能"},
+		Rule:            []string{"Rule"},
+		Background:      []string{"背景"},
+		Scenario:        []string{"場景", "劇本"},
+		ScenarioOutline: []string{"場景大綱", "劇本大綱"},
+		Examples:        []string{"例子"},
+		Given:           []string{"* ", "假如", "假設", "假定"},
+		When:            []string{"* ", "當"},
+		Then:            []string{"* ", "那麼"},
+		And:             []string{"* ", "而且", "並且", "同時"},
+		But:             []string{"* ", "但是"},
+	},
+}
@@ -0,0 +1,99 @@
+//go:build ignore
+// +build ignore
+
+// Generates languages.go from gherkin-languages.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+)
+
+type dialect struct {
+	Name            string   `json:"name"`
+	Native          string   `json:"native"`
+	Feature         []string `json:"feature"`
+	Rule            []string `json:"rule"`
+	Background      []string `json:"background"`
+	Scenario        []string `json:"scenario"`
+	ScenarioOutline []string `json:"scenarioOutline"`
+	Examples        []string `json:"examples"`
+	Given           []string `json:"given"`
+	When            []string `json:"when"`
+	Then            []string `json:"then"`
+	And             []string `json:"and"`
+	But             []string `json:"but"`
+}
+
+func main() {
+	data, err := ioutil.ReadFile("gherkin-languages.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dialects := map[string]dialect{}
+
+	if err := json.Unmarshal(data, &dialects); err != nil {
+		log.Fatal(err)
+	}
+
+	languages := []string{}
+
+	for language := range dialects {
+		languages = append(languages, language)
+	}
+
+	sort.Strings(languages)
+
+	code := &bytes.Buffer{}
+
+	fmt.Fprintln(code, "// Code generated by languages_gen.go from gherkin-languages.json; DO NOT EDIT.")
+	fmt.Fprintln(code)
+	fmt.Fprintln(code, "package gherkin")
+	fmt.Fprintln(code)
+	fmt.Fprintln(code, "var dialects = map[string]*Dialect{")
+
+	for _, language := range languages {
+		d := dialects[language]
+
+		fmt.Fprintf(code, "%q: {\n", language)
+		fmt.Fprintf(code, "Language: %q,\nName: %q,\nNative: %q,\n", language, d.Name, d.Native)
+
+		for _, field := range []struct {
+			name     string
+			keywords []string
+		}{
+			{"Feature", d.Feature},
+			{"Rule", d.Rule},
+			{"Background", d.Background},
+			{"Scenario", d.Scenario},
+			{"ScenarioOutline", d.ScenarioOutline},
+			{"Examples", d.Examples},
+			{"Given", d.Given},
+			{"When", d.When},
+			{"Then", d.Then},
+			{"And", d.And},
+			{"But", d.But},
+		} {
+			fmt.Fprintf(code, "%s: %#v,\n", field.name, field.keywords)
+		}
+
+		fmt.Fprintln(code, "},")
+	}
+
+	fmt.Fprintln(code, "}")
+
+	source, err := format.Source(code.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile("languages.go", source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
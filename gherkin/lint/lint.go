@@ -0,0 +1,202 @@
+// Package lint checks Gherkin documents against house style rules, e.g.
+// that scenario names are unique and that no scenario lacks steps. Rules
+// are enabled and configured by Config, usually read from gomate.json.
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gomate.io/gomate/gherkin"
+)
+
+// Names of rules, reported in diagnostics.
+const (
+	ParseError             = "parse-error"
+	DuplicateScenarioNames = "duplicate-scenario-names"
+	EmptyScenario          = "empty-scenario"
+	ThenBeforeWhen         = "then-before-when"
+	MaxSteps               = "max-steps"
+	RequiredTags           = "required-tags"
+	TrailingWhitespace     = "trailing-whitespace"
+)
+
+// Config enables rules, each field is named as its rule in JSON.
+// MaxSteps is the maximum number of steps per scenario, excluding
+// background steps, 0 disables the rule. RequiredTags holds regular
+// expressions, which each must match a tag of every scenario,
+// including tags inherited from features and rules.
+type Config struct {
+	DuplicateScenarioNames bool     `json:"duplicate-scenario-names"`
+	EmptyScenario          bool     `json:"empty-scenario"`
+	ThenBeforeWhen         bool     `json:"then-before-when"`
+	MaxSteps               int      `json:"max-steps"`
+	RequiredTags           []string `json:"required-tags"`
+	TrailingWhitespace     bool     `json:"trailing-whitespace"`
+}
+
+// DefaultConfig returns configuration with all rules enabled, except required tags.
+func DefaultConfig() Config {
+	return Config{
+		DuplicateScenarioNames: true,
+		EmptyScenario:          true,
+		ThenBeforeWhen:         true,
+		MaxSteps:               10,
+		RequiredTags:           []string{},
+		TrailingWhitespace:     true,
+	}
+}
+
+// Diagnostic reports a violation of Rule at a location in the feature file
+// named by URI. Field names in JSON are suitable for editor integrations.
+type Diagnostic struct {
+	URI     string `json:"uri"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s (%s)", d.URI, d.Line, d.Column, d.Message, d.Rule)
+}
+
+// Lint checks src, a feature file named by uri, against rules enabled by config.
+// Diagnostics are returned in order of location. A feature file that can't be
+// parsed is reported by a single parse-error diagnostic. An error is returned
+// if a required tag isn't a valid regular expression.
+func Lint(uri string, src []byte, config Config) ([]Diagnostic, error) {
+	required := []*regexp.Regexp{}
+
+	for _, expr := range config.RequiredTags {
+		r, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %s", RequiredTags, expr, err)
+		}
+
+		required = append(required, r)
+	}
+
+	l := &linter{uri: uri, config: config, required: required}
+
+	if config.TrailingWhitespace {
+		l.trailingWhitespace(src)
+	}
+
+	documents, err := gherkin.Parse(uri, bytes.NewReader(src))
+	if e, ok := err.(gherkin.ParseError); ok {
+		unexpected := "end of file"
+
+		if e.Text != "" {
+			unexpected = fmt.Sprintf("%q", e.Text)
+		}
+
+		l.report(e.Location, ParseError, fmt.Sprintf("unexpected %s, %s expected", unexpected, e.Expected))
+	} else if err != nil {
+		return nil, err
+	}
+
+	for _, document := range documents {
+		l.feature(document.Feature)
+	}
+
+	sort.SliceStable(l.diagnostics, func(i, j int) bool {
+		a, b := l.diagnostics[i], l.diagnostics[j]
+		return a.Line < b.Line || a.Line == b.Line && a.Column < b.Column
+	})
+
+	return l.diagnostics, nil
+}
+
+type linter struct {
+	uri         string
+	config      Config
+	required    []*regexp.Regexp
+	diagnostics []Diagnostic
+}
+
+func (l *linter) report(location gherkin.Location, rule, message string) {
+	l.diagnostics = append(l.diagnostics, Diagnostic{l.uri, location.Line, location.Column, rule, message})
+}
+
+func (l *linter) trailingWhitespace(src []byte) {
+	for i, line := range strings.Split(string(src), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+
+		if trimmed := strings.TrimRight(line, "\t "); trimmed != line {
+			l.report(gherkin.Location{Line: i + 1, Column: len([]rune(trimmed)) + 1}, TrailingWhitespace, "trailing whitespace")
+		}
+	}
+}
+
+// feature checks scenarios of feature, including scenarios of its rules.
+func (l *linter) feature(feature *gherkin.Feature) {
+	names := map[string]int{} // Line of first scenario, keyed by name
+
+	check := func(scenario *gherkin.Scenario, inherited []*gherkin.Tag) {
+		if line, ok := names[scenario.Name]; ok && l.config.DuplicateScenarioNames {
+			l.report(scenario.Location, DuplicateScenarioNames, fmt.Sprintf("scenario name %q already used at line %d", scenario.Name, line))
+		} else if !ok {
+			names[scenario.Name] = scenario.Line
+		}
+
+		l.scenario(scenario, append(append([]*gherkin.Tag{}, inherited...), scenario.Tags...))
+	}
+
+	for _, child := range feature.Children {
+		if child.Scenario != nil {
+			check(child.Scenario, feature.Tags)
+		} else if child.Rule != nil {
+			for _, ruleChild := range child.Rule.Children {
+				if ruleChild.Scenario != nil {
+					check(ruleChild.Scenario, append(append([]*gherkin.Tag{}, feature.Tags...), child.Rule.Tags...))
+				}
+			}
+		}
+	}
+}
+
+func (l *linter) scenario(scenario *gherkin.Scenario, tags []*gherkin.Tag) {
+	if len(scenario.Steps) == 0 && l.config.EmptyScenario {
+		l.report(scenario.Location, EmptyScenario, fmt.Sprintf("scenario %q has no steps", scenario.Name))
+	}
+
+	if max := l.config.MaxSteps; max > 0 && len(scenario.Steps) > max {
+		l.report(scenario.Location, MaxSteps, fmt.Sprintf("scenario %q has %d steps, more than %d", scenario.Name, len(scenario.Steps), max))
+	}
+
+	for _, r := range l.required {
+		if !hasTag(tags, r) {
+			l.report(scenario.Location, RequiredTags, fmt.Sprintf("scenario %q has no tag matching %q", scenario.Name, r))
+		}
+	}
+
+	if l.config.ThenBeforeWhen {
+		then, typ := 0, "Given" // Line of first Then step, and type of preceding step
+
+		for _, step := range scenario.Steps {
+			if step.Type != "And" && step.Type != "But" && step.Type != "*" {
+				typ = step.Type
+			}
+
+			if typ == "Then" && then == 0 {
+				then = step.Line
+			} else if step.Type == "When" && then > 0 {
+				l.report(step.Location, ThenBeforeWhen, fmt.Sprintf("When step follows Then step at line %d", then))
+			}
+		}
+	}
+}
+
+func hasTag(tags []*gherkin.Tag, r *regexp.Regexp) bool {
+	for _, tag := range tags {
+		if r.MatchString(tag.Name) {
+			return true
+		}
+	}
+
+	return false
+}
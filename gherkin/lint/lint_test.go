@@ -0,0 +1,38 @@
+package lint_test
+
+import (
+	"fmt"
+
+	"gomate.io/gomate/gherkin/lint"
+)
+
+func ExampleLint() {
+	src := `Feature: Invoices
+
+  @owner:billing
+  Scenario: Send invoice
+    Given a customer
+    Then an invoice is sent
+    When I send an invoice
+
+  Scenario: Send invoice
+`
+
+	config := lint.DefaultConfig()
+	config.RequiredTags = []string{"^@owner:"}
+
+	diagnostics, err := lint.Lint("features/invoices.feature", []byte(src), config)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, diagnostic := range diagnostics {
+		fmt.Println(diagnostic)
+	}
+
+	// Output:
+	// features/invoices.feature:7:5: When step follows Then step at line 6 (then-before-when)
+	// features/invoices.feature:9:3: scenario name "Send invoice" already used at line 4 (duplicate-scenario-names)
+	// features/invoices.feature:9:3: scenario "Send invoice" has no steps (empty-scenario)
+	// features/invoices.feature:9:3: scenario "Send invoice" has no tag matching "^@owner:" (required-tags)
+}
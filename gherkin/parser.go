@@ -0,0 +1,520 @@
+package gherkin
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ParseError reports a line in a Gherkin document, which isn't expected
+// where it is found. Text is the line without surrounding whitespace,
+// empty at end of file. Expected hints what was expected instead e.g.,
+// "step keyword".
+type ParseError struct {
+	URI string
+	Location
+	Text     string
+	Expected string
+}
+
+func (e ParseError) Error() string {
+	position := fmt.Sprintf("%s:%d:%d", e.URI, e.Line, e.Column)
+	unexpected := "end of file"
+
+	if e.URI == "" {
+		position = fmt.Sprintf("line %d, column %d", e.Line, e.Column)
+	}
+
+	if e.Text != "" {
+		unexpected = fmt.Sprintf("%q", e.Text)
+	}
+
+	return fmt.Sprintf("%s: unexpected %s, %s expected", position, unexpected, e.Expected)
+}
+
+// parser reads a Gherkin document line by line, the current line is
+// held until it has been processed by one of the parse methods.
+type parser struct {
+	scanner      *bufio.Scanner
+	uri          string
+	line         int    // Number of current line
+	text         string // Current line
+	more         bool   // False at end of file
+	grammar      *grammar
+	comments     []*Comment
+	descriptions map[int]int // First line of descriptions, keyed by line of their header
+}
+
+// Parse parses all Gherkin documents read from reader, uri names the source
+// in documents and errors e.g., path of the feature file. Each feature line,
+// and the tags and language header preceding it, begins a new document.
+//
+// Keywords are English, unless a "# language: sv" header preceding the
+// feature line selects another dialect.
+func Parse(uri string, reader io.Reader) ([]*GherkinDocument, error) {
+	p := &parser{scanner: bufio.NewScanner(reader), uri: uri}
+	p.grammar, _ = grammarFor(DefaultLanguage)
+	documents := []*GherkinDocument{}
+	tags := []*Tag{}
+
+	for p.next(); p.more; {
+		switch {
+		case p.language():
+			if language, _ := Language(p.text); !p.setLanguage(language) {
+				return nil, p.unexpected("language of " + strings.Join(Languages(), ", "))
+			}
+		case p.grammar.feature.MatchString(p.text):
+			feature, pending, err := p.parseFeature(tags)
+			if err != nil {
+				return nil, err
+			}
+
+			documents = append(documents, &GherkinDocument{p.uri, feature, p.comments, p.descriptions})
+			p.comments, p.descriptions, tags = nil, nil, pending
+
+			continue
+		case tagsRegexp.MatchString(p.text):
+			tags = append(tags, p.tags()...)
+		case p.ignored():
+		default:
+			return nil, p.unexpected("Feature: or tag")
+		}
+
+		p.next()
+	}
+
+	if len(documents) == 0 || len(tags) > 0 {
+		return nil, p.unexpectedEOF("Feature:")
+	}
+
+	return documents, nil
+}
+
+// ParseFiles parses Gherkin documents of all files, documents are named by file path.
+func ParseFiles(paths ...string) ([]*GherkinDocument, error) {
+	documents := []*GherkinDocument{}
+
+	for _, path := range paths {
+		file, err := os.Open(path) // #nosec
+		if err != nil {
+			return nil, err
+		}
+
+		parsed, err := Parse(path, file)
+		file.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, parsed...)
+	}
+
+	return documents, nil
+}
+
+func (p *parser) next() {
+	if p.more = p.scanner.Scan(); p.more {
+		p.line++
+		p.text = p.scanner.Text()
+	} else {
+		p.text = ""
+	}
+}
+
+// location returns location of keyword in current line.
+func (p *parser) location(keyword string) Location {
+	column := len([]rune(strings.SplitN(p.text, keyword, 2)[0])) + 1
+
+	return Location{Line: p.line, Column: column}
+}
+
+// unexpected returns a parse error for current line, hinting what was expected instead.
+func (p *parser) unexpected(expected string) error {
+	text := strings.TrimSpace(p.text)
+
+	return ParseError{p.uri, p.location(text), text, expected}
+}
+
+// unexpectedEOF returns a parse error for end of file, hinting what was expected instead.
+func (p *parser) unexpectedEOF(expected string) error {
+	if err := p.scanner.Err(); err != nil {
+		return err
+	}
+
+	return ParseError{p.uri, Location{Line: p.line + 1, Column: 1}, "", expected}
+}
+
+// language reports if current line is a language header.
+func (p *parser) language() bool {
+	_, ok := Language(p.text)
+	return ok
+}
+
+func (p *parser) setLanguage(language string) bool {
+	g, ok := grammarFor(language)
+	if ok {
+		p.grammar = g
+	}
+
+	return ok
+}
+
+// ignored reports if current line is empty or a comment, comments are recorded.
+func (p *parser) ignored() bool {
+	if commentRegexp.MatchString(p.text) {
+		p.comments = append(p.comments, &Comment{p.location("#"), p.text})
+		return true
+	}
+
+	return emptyLineRegexp.MatchString(p.text)
+}
+
+// tags returns tags of current line. A comment following the tags is
+// recorded as a comment of the line.
+func (p *parser) tags() []*Tag {
+	tags := []*Tag{}
+	offset := 0
+	matches := submatches(tagsRegexp, p.text)
+
+	if comment := matches["comment"]; comment != "" {
+		column := len([]rune(strings.TrimSuffix(p.text, comment))) + 1
+		p.comments = append(p.comments, &Comment{Location{p.line, column}, comment})
+	}
+
+	for _, field := range strings.Fields(matches["tags"]) {
+		i := strings.Index(p.text[offset:], field) + offset
+		tags = append(tags, &Tag{Location{p.line, len([]rune(p.text[:i])) + 1}, field})
+		offset = i + len(field)
+	}
+
+	return tags
+}
+
+// header returns location, keyword and name of the current line matched by one of the header regexps.
+func (p *parser) header(matches map[string]string) (Location, string, string) {
+	return p.location(matches["keyword"]), matches["keyword"], matches["name"]
+}
+
+// parseDescription returns text following the header at line, until a line matching
+// another header, tags or a table row. Steps ends descriptions of backgrounds and
+// scenarios. Comments are not part of descriptions, neither are surrounding empty
+// lines. The first line of the description is recorded, locating comments within it.
+func (p *parser) parseDescription(header int, steps bool) string {
+	lines := []string{}
+
+	for ; p.more; p.next() {
+		if p.language() || p.grammar.isHeader(p.text) || tagsRegexp.MatchString(p.text) || tableRowRegexp.MatchString(p.text) || steps && p.grammar.step.MatchString(p.text) {
+			break
+		} else if commentRegexp.MatchString(p.text) {
+			p.ignored()
+		} else if len(lines) > 0 || !emptyLineRegexp.MatchString(p.text) {
+			if len(lines) == 0 {
+				if p.descriptions == nil {
+					p.descriptions = map[int]int{}
+				}
+
+				p.descriptions[header] = p.line
+			}
+
+			lines = append(lines, strings.TrimRight(p.text, "\t "))
+		}
+	}
+
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// parseFeature parses a feature, until end of file or the next feature. Tags
+// preceding the next feature are returned as pending.
+func (p *parser) parseFeature(tags []*Tag) (feature *Feature, pending []*Tag, err error) {
+	feature = &Feature{Tags: tags, Language: p.grammar.dialect.Language}
+	feature.Location, feature.Keyword, feature.Name = p.header(submatches(p.grammar.feature, p.text))
+
+	p.next()
+	feature.Description = p.parseDescription(feature.Line, false)
+
+	var rule *Rule
+
+	for p.more {
+		switch {
+		case p.language() || p.grammar.feature.MatchString(p.text):
+			return // Next document
+		case p.grammar.rule.MatchString(p.text):
+			rule = &Rule{Tags: pending}
+			rule.Location, rule.Keyword, rule.Name = p.header(submatches(p.grammar.rule, p.text))
+			feature.Children = append(feature.Children, &FeatureChild{Rule: rule})
+			pending = nil
+
+			p.next()
+			rule.Description = p.parseDescription(rule.Line, false)
+
+			continue
+		case p.grammar.background.MatchString(p.text):
+			var background *Background
+
+			if len(pending) > 0 || rule == nil && len(feature.Children) > 0 || rule != nil && len(rule.Children) > 0 {
+				return nil, nil, p.unexpected("Scenario:, Rule: or tag") // Backgrounds precede scenarios, and aren't tagged
+			}
+
+			if background, err = p.parseBackground(); err != nil {
+				return nil, nil, err
+			}
+
+			if rule != nil {
+				rule.Children = append(rule.Children, &RuleChild{Background: background})
+			} else {
+				feature.Children = append(feature.Children, &FeatureChild{Background: background})
+			}
+
+			continue
+		case p.grammar.scenario.MatchString(p.text) || p.grammar.scenarioOutline.MatchString(p.text):
+			var scenario *Scenario
+
+			if scenario, pending, err = p.parseScenario(pending); err != nil {
+				return nil, nil, err
+			}
+
+			if rule != nil {
+				rule.Children = append(rule.Children, &RuleChild{Scenario: scenario})
+			} else {
+				feature.Children = append(feature.Children, &FeatureChild{Scenario: scenario})
+			}
+
+			continue
+		case tagsRegexp.MatchString(p.text):
+			pending = append(pending, p.tags()...)
+		case p.ignored():
+		default:
+			return nil, nil, p.unexpected("Scenario:, Rule: or tag")
+		}
+
+		p.next()
+	}
+
+	if len(pending) > 0 {
+		return nil, nil, p.unexpectedEOF("Scenario: or Rule:")
+	}
+
+	return
+}
+
+func (p *parser) parseBackground() (background *Background, err error) {
+	background = &Background{}
+	background.Location, background.Keyword, background.Name = p.header(submatches(p.grammar.background, p.text))
+
+	p.next()
+	background.Description = p.parseDescription(background.Line, true)
+	background.Steps, err = p.parseSteps()
+
+	return
+}
+
+// parseScenario parses a scenario or scenario outline, including examples. Tags
+// following the scenario, not preceding examples, are returned as pending.
+func (p *parser) parseScenario(tags []*Tag) (scenario *Scenario, pending []*Tag, err error) {
+	matches := submatches(p.grammar.scenario, p.text)
+
+	if matches == nil {
+		matches = submatches(p.grammar.scenarioOutline, p.text)
+	}
+
+	scenario = &Scenario{Tags: tags}
+	scenario.Location, scenario.Keyword, scenario.Name = p.header(matches)
+
+	p.next()
+	scenario.Description = p.parseDescription(scenario.Line, true)
+
+	if scenario.Steps, err = p.parseSteps(); err != nil {
+		return nil, nil, err
+	}
+
+	for p.more {
+		switch {
+		case p.grammar.examples.MatchString(p.text):
+			var examples *Examples
+
+			if examples, err = p.parseExamples(pending); err != nil {
+				return nil, nil, err
+			}
+
+			scenario.Examples = append(scenario.Examples, examples)
+			pending = nil
+
+			continue
+		case tagsRegexp.MatchString(p.text):
+			pending = append(pending, p.tags()...)
+		case p.ignored():
+		default:
+			return // Not examples, handled by caller
+		}
+
+		p.next()
+	}
+
+	return
+}
+
+// parseSteps parses steps and their arguments, until a line not being a step.
+func (p *parser) parseSteps() ([]*Step, error) {
+	steps := []*Step{}
+
+	for p.more {
+		switch {
+		case p.grammar.step.MatchString(p.text):
+			matches := submatches(p.grammar.step, p.text)
+			keyword := strings.TrimRight(matches["keyword"], "\t ")
+			step := &Step{
+				Location: p.location(keyword),
+				Keyword:  keyword,
+				Type:     p.grammar.stepKeywords[keyword],
+				Text:     matches["text"],
+			}
+
+			if keyword != matches["keyword"] {
+				step.Keyword += " " // Separated from text, as in dialect
+			}
+
+			steps = append(steps, step)
+			p.next()
+
+			var err error
+
+			if docStringRegexp.MatchString(p.text) {
+				step.DocString, err = p.parseDocString()
+			} else if tableRowRegexp.MatchString(p.text) {
+				step.DataTable = &DataTable{Location: p.location("|")}
+				step.DataTable.Rows, err = p.parseTable()
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		case p.ignored():
+		case p.language() || p.grammar.isHeader(p.text) || tagsRegexp.MatchString(p.text):
+			return steps, nil
+		default:
+			return nil, p.unexpected("step keyword")
+		}
+
+		p.next()
+	}
+
+	return steps, nil
+}
+
+// parseDocString parses a doc string, indentation of the opening delimiter
+// is removed from each line of content, escaped delimiters are unescaped.
+func (p *parser) parseDocString() (*DocString, error) {
+	matches := submatches(docStringRegexp, p.text)
+	indent := len(matches["indent"])
+	delimiter := matches["delimiter"]
+	escaped := strings.Replace(delimiter, delimiter[:1], `\`+delimiter[:1], -1)
+	docString := &DocString{Location: p.location(delimiter), MediaType: matches["mediatype"], Delimiter: delimiter}
+	lines := []string{}
+
+	for p.next(); p.more; p.next() {
+		if strings.TrimSpace(p.text) == delimiter {
+			docString.Content = strings.Join(lines, "\n")
+			p.next()
+
+			return docString, nil
+		}
+
+		line := p.text
+
+		for i := 0; i < indent && len(line) > 0 && (line[0] == ' ' || line[0] == '\t'); i++ {
+			line = line[1:]
+		}
+
+		lines = append(lines, strings.Replace(line, escaped, delimiter, -1))
+	}
+
+	return nil, p.unexpectedEOF("closing " + delimiter)
+}
+
+// parseTable parses table rows, all rows must have as many cells as the first row.
+func (p *parser) parseTable() ([]*TableRow, error) {
+	rows := []*TableRow{}
+
+	for ; p.more; p.next() {
+		if commentRegexp.MatchString(p.text) {
+			p.ignored()
+			continue
+		} else if !tableRowRegexp.MatchString(p.text) {
+			break
+		}
+
+		row := &TableRow{Location: p.location("|"), Cells: p.cells()}
+
+		if len(rows) > 0 && len(row.Cells) != len(rows[0].Cells) {
+			return nil, p.unexpected(fmt.Sprintf("row of %d cells", len(rows[0].Cells)))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// cells returns cells of current line, separated by "|". Within cells, "\|", "\\"
+// and "\n" are unescaped to "|", "\" and newline. Text after the last "|" is ignored.
+func (p *parser) cells() []*TableCell {
+	cells := []*TableCell{}
+	runes := []rune(p.text)
+	start := strings.IndexRune(p.text, '|')
+	column := len([]rune(p.text[:start])) + 2 // Column following the first "|"
+	value := []rune{}
+
+	for i := column - 1; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && i+1 < len(runes):
+			i++
+
+			if runes[i] == 'n' {
+				value = append(value, '\n')
+			} else if runes[i] == '|' || runes[i] == '\\' {
+				value = append(value, runes[i])
+			} else {
+				value = append(value, '\\', runes[i])
+			}
+		case runes[i] == '|':
+			text := string(value)
+			trimmed := strings.TrimLeft(text, "\t ")
+			cell := &TableCell{Location{p.line, column + len([]rune(text)) - len([]rune(trimmed))}, strings.TrimSpace(text)}
+			cells = append(cells, cell)
+			column, value = i+2, []rune{}
+		default:
+			value = append(value, runes[i])
+		}
+	}
+
+	return cells
+}
+
+// parseExamples parses examples of a scenario outline, including its table.
+func (p *parser) parseExamples(tags []*Tag) (examples *Examples, err error) {
+	examples = &Examples{Tags: tags}
+	examples.Location, examples.Keyword, examples.Name = p.header(submatches(p.grammar.examples, p.text))
+
+	p.next()
+	examples.Description = p.parseDescription(examples.Line, false)
+
+	rows, err := p.parseTable()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) > 0 {
+		examples.TableHeader, examples.TableBody = rows[0], rows[1:]
+	}
+
+	return
+}
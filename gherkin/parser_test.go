@@ -0,0 +1,90 @@
+package gherkin_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"gomate.io/gomate/gherkin"
+)
+
+func ExampleParse() {
+	text := `@accounts
+Feature: Accounts
+
+  Background:
+    Given an empty database
+
+  Scenario: Create account
+    When I create an account
+
+Feature: Invoices
+
+  Scenario: Send invoice
+    When I send an invoice
+`
+
+	documents, err := gherkin.Parse("features/billing.feature", bytes.NewBufferString(text))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, document := range documents {
+		feature := document.Feature
+		fmt.Println(feature.Keyword, feature.Name, feature.Location.Line, len(feature.Tags), len(feature.Children))
+	}
+
+	_, err = gherkin.Parse("features/billing.feature", bytes.NewBufferString("Feature: Accounts\n  Scenario: Create\n    Given an empty database\n    an account\n"))
+	fmt.Println(err)
+
+	// Output:
+	// Feature Accounts 2 1 2
+	// Feature Invoices 10 0 1
+	// features/billing.feature:4:5: unexpected "an account", step keyword expected
+}
+
+func ExampleCompile() {
+	text := `Feature: Inbox
+
+  Background:
+    Given an empty inbox
+
+  @mail
+  Scenario Outline: Receive mail from <sender>
+    When <sender> sends a mail
+    Then the inbox contains:
+      | sender   |
+      | <sender> |
+
+    Examples:
+      | sender |
+      | Alice  |
+      | Bob    |
+`
+
+	documents, err := gherkin.Parse("", bytes.NewBufferString(text))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pickle := range gherkin.Compile(documents[0]) {
+		fmt.Println(pickle.Name, pickle.Location.Line, pickle.Tags)
+
+		for _, step := range pickle.Steps {
+			fmt.Println("", step.Type, step.Text, step.Background)
+		}
+
+		fmt.Println("", pickle.Steps[2].DataTable.Rows[1].Cells[0].Value)
+	}
+
+	// Output:
+	// Receive mail from Alice 15 [@mail]
+	//  Given an empty inbox true
+	//  When Alice sends a mail false
+	//  Then the inbox contains: false
+	//  Alice
+	// Receive mail from Bob 16 [@mail]
+	//  Given an empty inbox true
+	//  When Bob sends a mail false
+	//  Then the inbox contains: false
+	//  Bob
+}
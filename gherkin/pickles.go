@@ -0,0 +1,172 @@
+package gherkin
+
+import "strings"
+
+// Pickle is an executable test case compiled from a scenario, or from a row of
+// examples of a scenario outline. Location is the position of the scenario, or
+// of the examples row. Tags holds names of the tags of the feature, rule,
+// scenario and examples. Scenario is the scenario the pickle is compiled from.
+type Pickle struct {
+	URI      string
+	Language string
+	Name     string
+	Location Location
+	Tags     []string
+	Steps    []*PickleStep
+	Scenario *Scenario
+}
+
+// PickleStep is a step of a pickle, where <placeholders> of scenario outlines
+// are replaced by values of the examples row. Location is the position of the
+// step in the scenario or background, Background reports the latter.
+type PickleStep struct {
+	Location
+	Keyword    string
+	Type       string
+	Text       string
+	DocString  *DocString
+	DataTable  *DataTable
+	Background bool
+}
+
+// Compile flattens document into pickles, in order of definition. Steps of
+// backgrounds of the feature, and of the rule, precedes steps of each scenario,
+// unless the scenario lacks steps.
+func Compile(document *GherkinDocument) []*Pickle {
+	pickles := []*Pickle{}
+	feature := document.Feature
+
+	if feature == nil {
+		return pickles
+	}
+
+	background := []*Step{}
+
+	for _, child := range feature.Children {
+		switch {
+		case child.Background != nil:
+			background = child.Background.Steps
+		case child.Rule != nil:
+			rule := child.Rule
+			ruleBackground := background
+
+			for _, ruleChild := range rule.Children {
+				if ruleChild.Background != nil {
+					ruleBackground = append(append([]*Step{}, background...), ruleChild.Background.Steps...)
+				} else {
+					pickles = append(pickles, compileScenario(document, ruleBackground, join(feature.Tags, rule.Tags), ruleChild.Scenario)...)
+				}
+			}
+		case child.Scenario != nil:
+			pickles = append(pickles, compileScenario(document, background, feature.Tags, child.Scenario)...)
+		}
+	}
+
+	return pickles
+}
+
+// compileScenario compiles a pickle of scenario, or one for each examples row of a scenario outline.
+func compileScenario(document *GherkinDocument, background []*Step, tags []*Tag, scenario *Scenario) []*Pickle {
+	tags = join(tags, scenario.Tags)
+
+	if len(scenario.Examples) == 0 {
+		return []*Pickle{newPickle(document, background, tags, scenario, scenario.Location, nil, nil)}
+	}
+
+	pickles := []*Pickle{}
+
+	for _, examples := range scenario.Examples {
+		if examples.TableHeader == nil {
+			continue
+		}
+
+		for _, row := range examples.TableBody {
+			pickles = append(pickles, newPickle(document, background, join(tags, examples.Tags), scenario, row.Location, examples.TableHeader, row))
+		}
+	}
+
+	return pickles
+}
+
+func newPickle(document *GherkinDocument, background []*Step, tags []*Tag, scenario *Scenario, location Location, header, row *TableRow) *Pickle {
+	pickle := &Pickle{
+		URI:      document.URI,
+		Language: document.Feature.Language,
+		Name:     replace(scenario.Name, header, row),
+		Location: location,
+		Tags:     []string{},
+		Steps:    []*PickleStep{},
+		Scenario: scenario,
+	}
+
+	for _, tag := range tags {
+		pickle.Tags = append(pickle.Tags, tag.Name)
+	}
+
+	if len(scenario.Steps) == 0 {
+		return pickle // Background are not tested for scenarios without steps
+	}
+
+	for _, step := range background {
+		pickle.Steps = append(pickle.Steps, newPickleStep(step, true, nil, nil))
+	}
+
+	for _, step := range scenario.Steps {
+		pickle.Steps = append(pickle.Steps, newPickleStep(step, false, header, row))
+	}
+
+	return pickle
+}
+
+func newPickleStep(step *Step, background bool, header, row *TableRow) *PickleStep {
+	pickleStep := &PickleStep{
+		Location:   step.Location,
+		Keyword:    step.Keyword,
+		Type:       step.Type,
+		Text:       replace(step.Text, header, row),
+		Background: background,
+	}
+
+	if step.DocString != nil {
+		docString := *step.DocString
+		docString.MediaType = replace(docString.MediaType, header, row)
+		docString.Content = replace(docString.Content, header, row)
+		pickleStep.DocString = &docString
+	}
+
+	if step.DataTable != nil {
+		pickleStep.DataTable = &DataTable{Location: step.DataTable.Location}
+
+		for _, tableRow := range step.DataTable.Rows {
+			r := &TableRow{Location: tableRow.Location}
+
+			for _, cell := range tableRow.Cells {
+				r.Cells = append(r.Cells, &TableCell{cell.Location, replace(cell.Value, header, row)})
+			}
+
+			pickleStep.DataTable.Rows = append(pickleStep.DataTable.Rows, r)
+		}
+	}
+
+	return pickleStep
+}
+
+// replace replaces <placeholders> in text by values of row, in the column named by header.
+func replace(text string, header, row *TableRow) string {
+	if header == nil {
+		return text
+	}
+
+	for i, cell := range header.Cells {
+		if i < len(row.Cells) {
+			text = strings.Replace(text, "<"+cell.Value+">", row.Cells[i].Value, -1)
+		}
+	}
+
+	return text
+}
+
+// join returns tags followed by more tags, without modifying tags.
+func join(tags []*Tag, more []*Tag) []*Tag {
+	return append(append([]*Tag{}, tags...), more...)
+}
@@ -1,21 +1,30 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log/syslog"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dekelund/stdres"
 	"github.com/urfave/cli/v2"
 
 	"gomate.io/gomate/compiler/definition"
 	"gomate.io/gomate/compiler/feature"
+	"gomate.io/gomate/gherkin"
+	"gomate.io/gomate/gherkin/lint"
+	"gomate.io/gomate/internal/diff"
 	"gomate.io/gomate/internal/highlighter"
 	"gomate.io/gomate/logging"
+	"gomate.io/gomate/unbrokenwing"
 )
 
 const (
@@ -28,6 +37,8 @@ var settings struct {
 	PPrint     bool
 	CWD        string
 	DefPattern string
+	Suite      unbrokenwing.Settings
+	Lint       lint.Config
 }
 
 var cwd = "."
@@ -92,13 +103,27 @@ func main() {
 			Value: "step_definitions",
 			Usage: "Definitions folder name, should be located in features folder",
 		},
+		&cli.StringFlag{
+			Name:  "config",
+			Value: "gomate.json",
+			Usage: "JSON configuration file, holding default values of global flags and lint rules",
+		},
 		&cli.StringFlag{
 			Name:  "dir",
 			Value: ".",
-			Usage: "Relative path, to a feature-file or -directory (Current value: " + cwd + ").",
+			Usage: "Relative path, to a feature-file, optionally with :LINE selectors, or -directory (Current value: " + cwd + ").",
 		},
 	}
 
+	app.Before = func(c *cli.Context) error {
+		// Exits here, usage is printed for errors returned by Before, and logging isn't set up yet
+		if err := applyConfig(c); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		return nil
+	}
 	app.Commands = []*cli.Command{{
 		Name:    "feature-files",
 		Aliases: []string{},
@@ -118,11 +143,88 @@ func main() {
 		Flags:   []cli.Flag{},
 		Action:  printDefinitionsCodeCMD,
 	}, {
-		Name:    "test",
-		Aliases: []string{"t"},
-		Usage:   "Tests either a test directory with features in it, or a .feature file",
-		Flags:   []cli.Flag{},
-		Action:  testCMD,
+		Name:      "test",
+		Aliases:   []string{"t"},
+		Usage:     "Tests either a test directory with features in it, or a .feature file",
+		ArgsUsage: "[path/file.feature[:LINE...] | path/dir | @rerun.txt]...",
+		Flags: append(suiteFlags(),
+			&cli.StringFlag{
+				Name:  "rerun-file",
+				Value: "rerun.txt",
+				Usage: "File listing failing and undefined scenarios as path.feature:LINE, test them again with: gomate test @rerun.txt",
+			},
+			&cli.StringFlag{
+				Name:  "attempts-file",
+				Usage: "File recording each attempt of retried scenarios as a line of JSON, with location, attempt number, result and error",
+			},
+		),
+		Action: testCMD,
+	}, {
+		Name:      "fmt",
+		Aliases:   []string{},
+		Usage:     "Formats feature files in canonical layout, printed to STDOUT unless -w or -d is given",
+		ArgsUsage: "[path/file.feature | path/dir]...",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "w",
+				Usage: "Write result to feature files, instead of STDOUT",
+			},
+			&cli.BoolFlag{
+				Name:  "d",
+				Usage: "Print diffs of feature files not formatted in canonical layout, instead of formatted files",
+			},
+		},
+		Action: fmtCMD,
+	}, {
+		Name:      "lint",
+		Aliases:   []string{},
+		Usage:     "Checks feature files against rules configured by lint in the configuration file",
+		ArgsUsage: "[path/file.feature | path/dir]...",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "format",
+				Value: "text",
+				Usage: "Format of diagnostics: text, as file:line:column: message (rule), or json for editors",
+			},
+		},
+		Action: lintCMD,
+	}, {
+		Name:      "init",
+		Aliases:   []string{},
+		Usage:     "Creates features and step definitions directories, with examples, and a configuration file",
+		ArgsUsage: "[dir]",
+		Flags:     []cli.Flag{},
+		Action:    initCMD,
+	}, {
+		Name:      "snippets",
+		Aliases:   []string{},
+		Usage:     "Prints step definitions of undefined steps, grouped by feature directory",
+		ArgsUsage: "[path/file.feature[:LINE...] | path/dir | @rerun.txt]...",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "write",
+				Usage: "Append step definitions to step definition files named after the feature files, instead of STDOUT",
+			},
+			&cli.StringFlag{
+				Name:  "snippet-style",
+				Value: unbrokenwing.RegexpSnippets,
+				Usage: "Style of snippets for undefined steps: regexp, or cucumber for Cucumber Expressions",
+			},
+		},
+		Action: snippetsCMD,
+	}, {
+		Name:      "watch",
+		Aliases:   []string{"w"},
+		Usage:     "Tests features, and tests them again when feature files, step definitions or Go files change",
+		ArgsUsage: "[path/file.feature[:LINE...] | path/dir | @rerun.txt]...",
+		Flags: append(suiteFlags(),
+			&cli.DurationFlag{
+				Name:  "interval",
+				Value: 500 * time.Millisecond,
+				Usage: "Time between polls for changed files, tests start when a poll finds no further changes",
+			},
+		),
+		Action: watchCMD,
 	}}
 
 	if err := app.Run(os.Args); err != nil {
@@ -205,54 +307,484 @@ func printDefinitionsCodeCMD(c *cli.Context) error {
 
 	definitions, _ := parseDir(dir)
 
+	if !settings.Forensic {
+		defer definitions.Remove()
+	}
+
 	defs := definitions.Code()
 
 	if settings.PPrint {
 		defs = highlighter.Definition(defs)
 	}
 
-	logging.Infof(defs)
+	types, err := definitions.ParameterTypes()
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	logging.Info(defs)
+	logging.Info(parameterTypesText(types))
 
 	return nil
 }
 
+// parameterTypesText lists built-in and custom parameter types
+// available to Cucumber Expressions as Go comments.
+func parameterTypesText(types map[string]string) string {
+	builtin := unbrokenwing.ParameterTypes()
+	names := []string{}
+
+	for name := range types {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	text := "\n// Parameter types:"
+
+	for _, name := range names {
+		origin := "built-in"
+		if _, ok := builtin[name]; !ok {
+			origin = "custom"
+		}
+
+		text += fmt.Sprintf("\n//\t{%s}\t%s\t(%s)", name, types[name], origin)
+	}
+
+	return text
+}
+
 // testCMD search, compile and execute features defined in Gherik format where behaviours are defined in Go-Lang based files.
 // Behaviours might be undefined, which will end up as red text in stdout if the context c has pretty print enabled.
 func testCMD(c *cli.Context) error {
 	setupGlobals(c)
 	dir := c.String("dir")
 
-	definitions, features := parseDir(dir)
+	if err := setupSuite(c); err != nil {
+		return err
+	}
 
-	if !settings.Forensic {
-		defer definitions.Remove()
+	targets := c.Args().Slice()
+	if len(targets) == 0 {
+		targets = []string{dir}
+	}
+
+	targets, err := expandTargets(targets)
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	// Dry runs leaves rerun file of earlier test untouched
+	if settings.Suite.Rerun = c.String("rerun-file"); settings.Suite.DryRun {
+		settings.Suite.Rerun = ""
+	} else if settings.Suite.Rerun != "" {
+		if settings.Suite.Rerun, err = filepath.Abs(settings.Suite.Rerun); err != nil {
+			logging.Fatal(err.Error())
+		}
+
+		// Truncated here, the behaviour binaries appends failing scenarios
+		if err := ioutil.WriteFile(settings.Suite.Rerun, []byte{}, 0644); err != nil {
+			logging.Fatal(err.Error())
+		}
+	}
+
+	if settings.Suite.Attempts = c.String("attempts-file"); settings.Suite.Attempts != "" {
+		if settings.Suite.Attempts, err = filepath.Abs(settings.Suite.Attempts); err != nil {
+			logging.Fatal(err.Error())
+		}
+
+		// Truncated here, the behaviour binaries appends attempts
+		if err := ioutil.WriteFile(settings.Suite.Attempts, []byte{}, 0644); err != nil {
+			logging.Fatal(err.Error())
+		}
+	}
+
+	tests := []test{}
+	groups := collectFeatures(targets)
+
+	if invalid := parseFeatures(groups); invalid > 0 {
+		return cli.Exit(fmt.Sprintf("%d feature files could not be parsed", invalid), 1)
+	}
+
+	for _, list := range groups {
+		definitions := compileDefinitions(list.Definitions)
+
+		if !settings.Forensic {
+			defer definitions.Remove()
+		}
+
+		for _, file := range list.Features {
+			tests = append(tests, test{&definitions, file, list.Lines[file]})
+		}
+	}
+
+	return runTests(tests)
+}
+
+// suiteFlags returns flags configuring how features are tested, see setupSuite.
+func suiteFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "strict-keywords",
+			Usage: "Only match step definitions registered with the steps keyword, And/But resolves to preceding keyword",
+		},
+		&cli.IntFlag{
+			Name:  "parallel",
+			Value: 1,
+			Usage: "Number of scenarios within each feature file tested concurrently, feature files are tested one at a time and scenarios tagged @serial never runs concurrently",
+		},
+		&cli.BoolFlag{
+			Name:  "fail-fast",
+			Usage: "Stop testing scenarios and feature files after first failure",
+		},
+		&cli.StringFlag{
+			Name:  "order",
+			Value: "defined",
+			Usage: "Order of feature files and scenarios: defined, random or random:SEED to reproduce an earlier order",
+		},
+		&cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "Match steps against step definitions without calling them, fails on undefined or ambiguous steps",
+		},
+		&cli.IntFlag{
+			Name:  "retry",
+			Usage: "Number of times failing scenarios are tested again, scenarios tagged @retry(N) overrides",
+		},
+		&cli.StringFlag{
+			Name:  "name",
+			Usage: "Only test scenarios with names matching regular expression",
+		},
+		&cli.StringFlag{
+			Name:  "snippet-style",
+			Value: unbrokenwing.RegexpSnippets,
+			Usage: "Style of snippets for undefined steps: regexp, or cucumber for Cucumber Expressions",
+		},
+	}
+}
+
+// setupSuite configures settings.Suite by flags of c, see suiteFlags.
+func setupSuite(c *cli.Context) error {
+	settings.Suite = unbrokenwing.Settings{
+		Pretty:         settings.PPrint,
+		StrictKeywords: c.Bool("strict-keywords"),
+		Parallel:       c.Int("parallel"),
+		FailFast:       c.Bool("fail-fast"),
+		Name:           c.String("name"),
+		DryRun:         c.Bool("dry-run"),
+		Retry:          c.Int("retry"),
+		SnippetStyle:   c.String("snippet-style"),
+	}
+
+	if err := checkSnippetStyle(settings.Suite.SnippetStyle); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	if _, err := regexp.Compile(settings.Suite.Name); err != nil {
+		return cli.Exit(fmt.Sprintf("invalid --name: %s", err), 1)
+	}
+
+	if err := settings.Suite.SetOrder(c.String("order")); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	return nil
+}
+
+// test is a feature file tested by compiled step definitions,
+// lines selects scenarios to test, all if empty.
+type test struct {
+	definitions *definition.Definitions
+	file        string
+	lines       []int
+}
+
+// runTests tests feature files as configured by settings.Suite, and
+// returns an error if any feature file failed or couldn't be tested.
+func runTests(tests []test) error {
+	settings.Suite.Shuffler("features")(len(tests), func(i, j int) {
+		tests[i], tests[j] = tests[j], tests[i]
+	})
+
+	if settings.Suite.Random {
+		defer logging.Infof("Randomized with seed %d, rerun in same order with --order random:%d", settings.Suite.Seed, settings.Suite.Seed)
 	}
 
+	failed, broken := 0, 0
+
 	// #nosec
-	for _, file := range features {
-		fd, err := os.Open(file)
+	for i, test := range tests {
+		if settings.Suite.FailFast && failed+broken > 0 {
+			skipped := []string{}
+
+			for _, test := range tests[i:] {
+				skipped = append(skipped, strings.TrimPrefix(test.file, cwd+pathSeparator))
+			}
+
+			logging.Noticef("Skipped %d feature files due to fail fast mode:\n\t%s", len(skipped), strings.Join(skipped, "\n\t"))
+			break
+		}
+
+		fd, err := os.Open(test.file)
 		if err != nil {
 			logging.Fatal(err.Error())
 		}
 		defer fd.Close()
 
-		definitions.Run(fd, settings.PPrint)
+		s := settings.Suite
+		s.Feature = strings.TrimPrefix(test.file, cwd+pathSeparator)
+		s.Lines = test.lines
+
+		// Continue with remaining features, even if the behaviour binary crashed
+		switch err := test.definitions.Run(fd, s); err {
+		case nil:
+		case definition.ErrFailed:
+			failed++
+		default:
+			logging.Errf("%s: %s", strings.TrimPrefix(test.file, cwd+pathSeparator), err)
+			broken++
+		}
+	}
+
+	if broken > 0 {
+		return cli.Exit(fmt.Sprintf("%d of %d feature files could not be tested", broken, len(tests)), 1)
+	} else if failed > 0 {
+		return cli.Exit(fmt.Sprintf("%d of %d feature files failed", failed, len(tests)), 1)
 	}
 
 	return nil
 }
 
-func parseDir(path string) (definition.Definitions, []string) {
-	var err error
-	var list = feature.List{}
-	var defs = []io.Reader{}
+// fmtCMD formats feature files of targets in canonical layout, see gherkin.Fprint.
+// Feature files that can't be parsed are left untouched, and fails the command.
+func fmtCMD(c *cli.Context) error {
+	setupGlobals(c)
+
+	targets := c.Args().Slice()
+	if len(targets) == 0 {
+		targets = []string{c.String("dir")}
+	}
+
+	invalid := 0
+
+	for _, target := range targets {
+		files, err := feature.Paths(target)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		for _, file := range files {
+			name := strings.TrimPrefix(file, cwd+pathSeparator)
+
+			src, err := ioutil.ReadFile(file) // #nosec
+			if err != nil {
+				logging.Fatal(err.Error())
+			}
+
+			formatted, err := gherkin.Format(name, src)
+			if err != nil {
+				logging.Err(err.Error())
+				invalid++
+
+				continue
+			}
+
+			switch {
+			case c.Bool("d"):
+				os.Stdout.WriteString(diff.Unified(name+".orig", name, string(src), string(formatted)))
+			case !c.Bool("w"):
+				os.Stdout.Write(formatted)
+			case string(src) != string(formatted):
+				if err := ioutil.WriteFile(file, formatted, 0644); err != nil {
+					logging.Fatal(err.Error())
+				}
+			}
+		}
+	}
+
+	if invalid > 0 {
+		return cli.Exit(fmt.Sprintf("%d feature files could not be parsed", invalid), 1)
+	}
+
+	return nil
+}
+
+// lintCMD checks feature files of targets against lint rules of the configuration
+// file, and fails if any rule is violated.
+func lintCMD(c *cli.Context) error {
+	setupGlobals(c)
+
+	format := c.String("format")
+	if format != "text" && format != "json" {
+		return cli.Exit(fmt.Sprintf("invalid --format %q, expected text or json", format), 1)
+	}
+
+	targets := c.Args().Slice()
+	if len(targets) == 0 {
+		targets = []string{c.String("dir")}
+	}
+
+	diagnostics := []lint.Diagnostic{}
+
+	for _, target := range targets {
+		files, err := feature.Paths(target)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		for _, file := range files {
+			src, err := ioutil.ReadFile(file) // #nosec
+			if err != nil {
+				logging.Fatal(err.Error())
+			}
+
+			found, err := lint.Lint(strings.TrimPrefix(file, cwd+pathSeparator), src, settings.Lint)
+			if err != nil {
+				return cli.Exit(fmt.Sprintf("%s: %s", c.String("config"), err), 1)
+			}
+
+			diagnostics = append(diagnostics, found...)
+		}
+	}
+
+	if format == "json" {
+		bytes, _ := json.MarshalIndent(diagnostics, "", "  ") // Diagnostics can always be marshalled
+		os.Stdout.Write(append(bytes, '\n'))
+	} else {
+		for _, diagnostic := range diagnostics {
+			os.Stdout.WriteString(diagnostic.String() + "\n")
+		}
+	}
+
+	if len(diagnostics) > 0 {
+		return cli.Exit("", 1)
+	}
 
-	if list, err = feature.ParseDir(path, settings.DefPattern); err != nil {
+	return nil
+}
+
+// checkSnippetStyle returns an error unless style is one of the snippet styles.
+func checkSnippetStyle(style string) error {
+	if style != unbrokenwing.RegexpSnippets && style != unbrokenwing.CucumberSnippets {
+		return fmt.Errorf("invalid --snippet-style %q, expected %s or %s", style, unbrokenwing.RegexpSnippets, unbrokenwing.CucumberSnippets)
+	}
+
+	return nil
+}
+
+// expandTargets replaces targets beginning with "@" by the
+// entries listed in that file, e.g. a rerun file.
+func expandTargets(targets []string) ([]string, error) {
+	expanded := []string{}
+
+	for _, target := range targets {
+		if !strings.HasPrefix(target, "@") {
+			expanded = append(expanded, target)
+			continue
+		}
+
+		bytes, err := ioutil.ReadFile(strings.TrimPrefix(target, "@"))
+		if err != nil {
+			return nil, err
+		}
+
+		expanded = append(expanded, strings.Fields(string(bytes))...)
+	}
+
+	return expanded, nil
+}
+
+// parseFeatures parses feature files before they are tested, parse errors are
+// logged with the location of the offending line. Returns number of invalid files.
+func parseFeatures(groups []feature.List) int {
+	invalid := 0
+
+	// #nosec
+	for _, list := range groups {
+		for _, file := range list.Features {
+			fd, err := os.Open(file)
+			if err != nil {
+				logging.Fatal(err.Error())
+			}
+
+			_, err = unbrokenwing.ParseFeatures(strings.TrimPrefix(file, cwd+pathSeparator), fd)
+			fd.Close()
+
+			if err != nil {
+				logging.Err(err.Error())
+				invalid++
+			}
+		}
+	}
+
+	return invalid
+}
+
+// collectFeatures parses targets i.e., feature files, directories or feature files
+// with line selectors, and groups features by step definitions. A feature selected
+// both in full and by lines is tested in full.
+func collectFeatures(targets []string) []feature.List {
+	groups, err := groupFeatures(targets)
+	if err != nil {
 		logging.Fatal(err.Error())
 	}
 
+	return groups
+}
+
+// groupFeatures is collectFeatures, returning an error instead of exiting
+// when a target can't be read, e.g. a feature file removed while watching.
+func groupFeatures(targets []string) ([]feature.List, error) {
+	groups := []feature.List{}
+	index := map[string]int{}
+
+	for _, target := range targets {
+		list, err := feature.ParseDir(target, settings.DefPattern)
+		if err != nil {
+			return nil, err
+		}
+
+		key := strings.Join(list.Definitions, "\n")
+
+		i, ok := index[key]
+		if !ok {
+			i, index[key] = len(groups), len(groups)
+			groups = append(groups, feature.List{Definitions: list.Definitions, Lines: map[string][]int{}})
+		}
+
+		for _, file := range list.Features {
+			lines, seen := groups[i].Lines[file]
+			selected, partial := list.Lines[file]
+
+			switch {
+			case !seen:
+				groups[i].Features = append(groups[i].Features, file)
+				groups[i].Lines[file] = selected
+			case lines == nil:
+			case partial:
+				groups[i].Lines[file] = append(lines, selected...)
+			default:
+				groups[i].Lines[file] = nil
+			}
+		}
+	}
+
+	return groups, nil
+}
+
+func parseDir(path string) (definition.Definitions, []string) {
+	list, err := feature.ParseDir(path, settings.DefPattern)
+	if err != nil {
+		logging.Fatal(err.Error())
+	}
+
+	return compileDefinitions(list.Definitions), list.Features
+}
+
+func compileDefinitions(paths []string) definition.Definitions {
+	var defs = []io.Reader{}
+
 	// #nosec
-	for _, def := range list.Definitions {
+	for _, def := range paths {
 		file, err := os.Open(def)
 		if err != nil {
 			logging.Fatal(err.Error())
@@ -262,5 +794,5 @@ func parseDir(path string) (definition.Definitions, []string) {
 		defer file.Close()
 	}
 
-	return definition.NewDefinitions(defs, settings.Forensic), list.Features
+	return definition.NewDefinitions(defs, settings.Forensic)
 }
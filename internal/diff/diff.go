@@ -0,0 +1,162 @@
+// Package diff provides unified diffs of text, e.g. to show changes
+// made by formatting feature files without writing them.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+const context = 3 // Unchanged lines surrounding each change
+
+const noNewline = `\ No newline at end of file`
+
+type operation struct {
+	kind byte // ' ', '-' or '+'
+	text string
+}
+
+// Unified returns changes from a to b in unified diff format, where names
+// of a and b are printed in the header. Empty if a and b are equal.
+func Unified(nameA, nameB, a, b string) string {
+	if a == b {
+		return ""
+	}
+
+	ops := edits(lines(a), lines(b))
+	out := fmt.Sprintf("--- %s\n+++ %s\n", nameA, nameB)
+
+	for start := 0; start < len(ops); {
+		if ops[start].kind == ' ' {
+			start++
+			continue
+		}
+
+		// Extend hunk until more than twice the context of unchanged lines
+		end, unchanged := start, 0
+
+		for i := start; i < len(ops) && unchanged <= 2*context; i++ {
+			if ops[i].kind == ' ' {
+				unchanged++
+			} else {
+				end, unchanged = i+1, 0
+			}
+		}
+
+		first := max(start-context, 0)
+		last := min(end+context, len(ops))
+		out += hunk(ops, first, last)
+		start = last
+	}
+
+	return out
+}
+
+// hunk formats ops[first:last] with a header of line ranges.
+func hunk(ops []operation, first, last int) string {
+	lineA, lineB := 1, 1
+
+	for _, op := range ops[:first] {
+		if op.kind != '+' {
+			lineA++
+		}
+
+		if op.kind != '-' {
+			lineB++
+		}
+	}
+
+	body, countA, countB := "", 0, 0
+
+	for _, op := range ops[first:last] {
+		body += string(op.kind) + op.text + "\n"
+
+		if op.kind != '+' {
+			countA++
+		}
+
+		if op.kind != '-' {
+			countB++
+		}
+	}
+
+	return fmt.Sprintf("@@ -%s +%s @@\n%s", lineRange(lineA, countA), lineRange(lineB, countB), body)
+}
+
+func lineRange(line, count int) string {
+	if count == 0 {
+		line-- // Empty ranges refers to the line before
+	}
+
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+// lines returns lines of text. A last line without newline is marked, so it
+// differs from the same line with newline.
+func lines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	split := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+
+	if !strings.HasSuffix(text, "\n") {
+		split[len(split)-1] += "\n" + noNewline
+	}
+
+	return split
+}
+
+// edits returns operations turning a into b, based on their longest common subsequence.
+func edits(a, b []string) []operation {
+	lcs := make([][]int, len(a)+1)
+
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := []operation{}
+	i, j := 0, 0
+
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, operation{' ', a[i]})
+			i, j = i+1, j+1
+		case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, operation{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, operation{'+', b[j]})
+			j++
+		}
+	}
+
+	return ops
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
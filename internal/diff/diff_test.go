@@ -0,0 +1,57 @@
+package diff_test
+
+import (
+	"fmt"
+
+	"gomate.io/gomate/internal/diff"
+)
+
+func ExampleUnified() {
+	a := "Feature: Invoices\n  Scenario: Send invoice\n    Given a customer\n    When I send an invoice\n"
+	b := "@billing\nFeature: Invoices\n  Scenario: Send invoice\n    When I send an invoice\n    Then it is paid\n"
+
+	fmt.Print(diff.Unified("a.feature", "b.feature", a, b))
+
+	// Output:
+	// --- a.feature
+	// +++ b.feature
+	// @@ -1,4 +1,5 @@
+	// +@billing
+	//  Feature: Invoices
+	//    Scenario: Send invoice
+	// -    Given a customer
+	//      When I send an invoice
+	// +    Then it is paid
+}
+
+func ExampleUnified_replace() {
+	a := "Feature: Invoices\n  Scenario: Send invoice\n    Given a customer\n"
+	b := "Feature: Invoices\n  Scenario: Send invoice\n    Given a new customer\n"
+
+	fmt.Print(diff.Unified("a.feature", "b.feature", a, b))
+
+	// Output:
+	// --- a.feature
+	// +++ b.feature
+	// @@ -1,3 +1,3 @@
+	//  Feature: Invoices
+	//    Scenario: Send invoice
+	// -    Given a customer
+	// +    Given a new customer
+}
+
+func ExampleUnified_noNewline() {
+	a := "Feature: Invoices\n  Scenario: Send invoice"
+	b := "Feature: Invoices\n  Scenario: Send invoice\n"
+
+	fmt.Print(diff.Unified("a.feature", "b.feature", a, b))
+
+	// Output:
+	// --- a.feature
+	// +++ b.feature
+	// @@ -1,2 +1,2 @@
+	//  Feature: Invoices
+	// -  Scenario: Send invoice
+	// \ No newline at end of file
+	// +  Scenario: Send invoice
+}
@@ -1,16 +1,60 @@
 package highlighter
 
-import "strings"
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"gomate.io/gomate/gherkin"
+)
 
 // Feature will syntax highlight gherkin code
-// by using colors for the shell.
+// by using colors for the shell. Keywords are
+// highlighted regardless of indentation, in
+// the dialect selected by the language header.
 func Feature(def string) string {
-	def = strings.Replace(def, "Feature: ", red+"Feature: "+reset, -1)
-	def = strings.Replace(def, "Scenario: ", red+"Scenario: "+reset, -1)
-	def = strings.Replace(def, " Given ", green+" Given "+reset, -1)
-	def = strings.Replace(def, " And ", green+" And "+reset, -1)
-	def = strings.Replace(def, " When ", blue+" When "+reset, -1)
-	def = strings.Replace(def, " Then ", yellow+" Then "+reset, -1)
+	dialect, _ := gherkin.LookupDialect(gherkin.DefaultLanguage)
+
+	for _, line := range strings.Split(def, "\n") {
+		if language, ok := gherkin.Language(line); ok {
+			if d, ok := gherkin.LookupDialect(language); ok {
+				dialect = d
+			}
+
+			break
+		}
+	}
+
+	var (
+		headers = [][]string{dialect.Feature, dialect.Rule, dialect.Background, dialect.Scenario, dialect.ScenarioOutline, dialect.Examples}
+		given   = [][]string{dialect.Given, dialect.And, dialect.But}
+	)
+
+	for _, keywords := range []struct {
+		keywords [][]string
+		suffix   string
+		color    string
+	}{
+		{headers, ":", red},
+		{given, "", green},
+		{[][]string{dialect.When}, "", blue},
+		{[][]string{dialect.Then}, "", yellow},
+	} {
+		alternatives := []string{}
+
+		for _, list := range keywords.keywords {
+			for _, keyword := range list {
+				if keyword != "* " || keywords.color == green {
+					alternatives = append(alternatives, regexp.QuoteMeta(keyword+keywords.suffix))
+				}
+			}
+		}
+
+		sort.Slice(alternatives, func(i, j int) bool { return len(alternatives[i]) > len(alternatives[j]) }) // Longest first
+
+		r := regexp.MustCompile(`(?m)^([\t ]*)(` + strings.Join(alternatives, "|") + `)`)
+		def = r.ReplaceAllString(def, "${1}"+keywords.color+"${2}"+reset)
+	}
 
 	return def
 }
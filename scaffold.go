@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/urfave/cli/v2"
+
+	"gomate.io/gomate/logging"
+)
+
+const exampleFeature = `Feature: Calculator
+  Each feature file describes one feature, as scenarios of steps.
+  Test features with: gomate test
+
+  Scenario: Add two numbers
+    Given a calculator
+    When I add 2 and 3
+    Then the result is 5
+`
+
+const exampleDefinitions = `package step_definitions
+
+import "fmt"
+
+// State of a scenario is kept in its Context, scenarios might be tested in parallel
+
+Given("a calculator", func(ctx *Context, args Args) error {
+	ctx.Set("result", 0)
+	return nil
+})
+
+When("I add {int} and {int}", func(ctx *Context, a, b int) error {
+	ctx.Set("result", a+b)
+	return nil
+})
+
+Then("the result is {int}", func(ctx *Context, expected int) error {
+	if result := ctx.Get("result").(int); result != expected {
+		return Failure(fmt.Sprintf("expected %d, got %d", expected, result))
+	}
+
+	return nil
+})
+`
+
+// initCMD creates a features directory with step definitions, an example
+// feature and a configuration file in the directory given as argument,
+// current directory by default. Existing files are left untouched.
+func initCMD(c *cli.Context) error {
+	setupGlobals(c)
+
+	root := "."
+	if c.Args().Len() > 0 {
+		root = c.Args().First()
+	}
+
+	conf := defaultConfig(c.App)
+	conf.Flags["dir"] = "features"
+	conf.Flags["step-definitions"] = settings.DefPattern
+
+	bytes, err := json.MarshalIndent(conf, "", "  ")
+	if err != nil {
+		logging.Fatal(err.Error())
+	}
+
+	features := filepath.Join(root, "features")
+	definitions := filepath.Join(features, settings.DefPattern)
+
+	if err := os.MkdirAll(definitions, 0755); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	files := []struct {
+		path    string
+		content string
+	}{
+		{filepath.Join(root, "gomate.json"), string(bytes) + "\n"},
+		{filepath.Join(features, "calculator.feature"), exampleFeature},
+		{filepath.Join(definitions, "calculator.go"), exampleDefinitions},
+	}
+
+	for _, file := range files {
+		if _, err := os.Stat(file.path); err == nil {
+			logging.Noticef("Skipped %s, file exists", file.path)
+			continue
+		}
+
+		if err := ioutil.WriteFile(file.path, []byte(file.content), 0644); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		logging.Infof("Created %s", file.path)
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"gomate.io/gomate/compiler/definition"
+	"gomate.io/gomate/compiler/feature"
+	"gomate.io/gomate/logging"
+	"gomate.io/gomate/unbrokenwing"
+)
+
+// snippetsCMD dry runs feature files of targets, and prints step definitions of
+// undefined steps grouped by feature directory. With --write, step definitions
+// are appended to the step definitions file named after the first feature file
+// using them, e.g. step_definitions/create.go for create.feature. Steps with a
+// pattern already present in step definition files are skipped, see definesPattern.
+func snippetsCMD(c *cli.Context) error {
+	setupGlobals(c)
+
+	if err := checkSnippetStyle(c.String("snippet-style")); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	targets := c.Args().Slice()
+	if len(targets) == 0 {
+		targets = []string{c.String("dir")}
+	}
+
+	targets, err := expandTargets(targets)
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	groups := collectFeatures(targets)
+
+	if invalid := parseFeatures(groups); invalid > 0 {
+		return cli.Exit(fmt.Sprintf("%d feature files could not be parsed", invalid), 1)
+	}
+
+	steps, err := undefinedSteps(groups, c.String("snippet-style"))
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	for _, dir := range steps.dirs {
+		definitions := filepath.Join(dir, settings.DefPattern)
+		file := filepath.Join(definitions, strings.TrimSuffix(filepath.Base(steps.files[dir]), ".feature")+".go")
+		code := ""
+
+		existing, err := definitionsCode(definitions)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		for _, step := range steps.steps[dir] {
+			if !definesPattern(existing, step.Pattern) {
+				code += fmt.Sprintf("\n// Undefined at %s\n%s\n", step.Location, step.Definition)
+			}
+		}
+
+		if code == "" {
+			continue
+		} else if !c.Bool("write") {
+			logging.Infof("// %s\n%s", strings.TrimPrefix(file, cwd+pathSeparator), code)
+			continue
+		}
+
+		if _, err := os.Stat(file); os.IsNotExist(err) {
+			code = "package step_definitions\n" + code
+		}
+
+		if err := appendFile(file, code); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		logging.Infof("Wrote step definitions of undefined steps to %s", strings.TrimPrefix(file, cwd+pathSeparator))
+	}
+
+	return nil
+}
+
+// undefined holds undefined steps grouped by feature directory. Directories
+// are kept in order of appearance, each with the first feature file found
+// in it, and steps with distinct patterns.
+type undefined struct {
+	dirs  []string
+	files map[string]string
+	steps map[string][]unbrokenwing.UndefinedStep
+}
+
+// undefinedSteps dry runs all features of groups, and collects their undefined steps
+// with step definitions generated in style, see unbrokenwing.Settings.
+func undefinedSteps(groups []feature.List, style string) (undefined, error) {
+	found := undefined{files: map[string]string{}, steps: map[string][]unbrokenwing.UndefinedStep{}}
+
+	snippets, err := ioutil.TempFile("", "gomate-snippets-")
+	if err != nil {
+		return found, err
+	}
+
+	snippets.Close()
+	defer os.Remove(snippets.Name())
+
+	// #nosec
+	for _, list := range groups {
+		definitions := compileDefinitions(list.Definitions)
+
+		if !settings.Forensic {
+			defer definitions.Remove()
+		}
+
+		for _, file := range list.Features {
+			fd, err := os.Open(file)
+			if err != nil {
+				logging.Fatal(err.Error())
+			}
+
+			s := unbrokenwing.Settings{
+				Pretty:       settings.PPrint,
+				DryRun:       true,
+				Feature:      strings.TrimPrefix(file, cwd+pathSeparator),
+				Lines:        list.Lines[file],
+				Snippets:     snippets.Name(),
+				SnippetStyle: style,
+			}
+
+			// Dry runs fails when steps are undefined
+			if err := definitions.Run(fd, s); err != nil && err != definition.ErrFailed {
+				logging.Errf("%s: %s", s.Feature, err)
+			}
+
+			fd.Close()
+		}
+	}
+
+	file, err := os.Open(snippets.Name())
+	if err != nil {
+		return found, err
+	}
+
+	defer file.Close()
+
+	seen := map[string]bool{} // Directory and pattern of collected steps
+
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		step := unbrokenwing.UndefinedStep{}
+
+		if err := json.Unmarshal(scanner.Bytes(), &step); err != nil {
+			return found, err
+		}
+
+		dir := filepath.Dir(step.File)
+
+		if _, ok := found.files[dir]; !ok {
+			found.dirs = append(found.dirs, dir)
+			found.files[dir] = step.File
+		}
+
+		if key := dir + "\n" + step.Pattern; !seen[key] {
+			seen[key] = true
+			found.steps[dir] = append(found.steps[dir], step)
+		}
+	}
+
+	return found, nil
+}
+
+// definesPattern returns true if code contains pattern as a string literal,
+// either interpreted e.g., "^I press (.+)$", or raw e.g., `^I press (.+)$`.
+func definesPattern(code, pattern string) bool {
+	return strings.Contains(code, strconv.Quote(pattern)) || strings.Contains(code, "`"+pattern+"`")
+}
+
+// definitionsCode returns code of all step definition files in dir, empty if dir doesn't exist.
+func definitionsCode(dir string) (string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
+
+	code := ""
+
+	for _, path := range paths {
+		bytes, err := ioutil.ReadFile(path) // #nosec
+		if err != nil {
+			return "", err
+		}
+
+		code += string(bytes)
+	}
+
+	return code, nil
+}
+
+func appendFile(path, text string) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) // #nosec
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.WriteString(text); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,48 @@
+package unbrokenwing
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Context holds state of one scenario, shared by its steps but isolated from
+// other scenarios, including scenarios tested concurrently, see Settings.Parallel.
+// Each scenario, and each retried attempt of it, starts with an empty Context.
+// Step definitions receive it by declaring *Context as their first parameter,
+// followed by Args or by the typed parameters of their pattern, e.g.
+//
+//	When("I add {int} and {int}", func(ctx *Context, a, b int) error {
+//		ctx.Set("result", a+b)
+//		return nil
+//	})
+//
+// Step definitions storing state in package level variables instead must be
+// safe for concurrent use, or be tagged @serial.
+type Context struct {
+	Scenario Scenario // Scenario being tested
+
+	mutex  sync.Mutex // Guards values, step definitions might start goroutines
+	values map[interface{}]interface{}
+}
+
+func newContext(scenario Scenario) *Context {
+	return &Context{Scenario: scenario, values: map[interface{}]interface{}{}}
+}
+
+// Set stores value by key, replacing any earlier value.
+func (ctx *Context) Set(key, value interface{}) {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	ctx.values[key] = value
+}
+
+// Get returns the value stored by key, nil if none has been stored.
+func (ctx *Context) Get(key interface{}) interface{} {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	return ctx.values[key]
+}
+
+var contextType = reflect.TypeOf((*Context)(nil))
@@ -1,14 +1,17 @@
 package unbrokenwing
 
 import (
-	"bufio"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/dekelund/stdres"
+	"gomate.io/gomate/gherkin"
 )
 
 var buffer stdres.Buffer
@@ -17,88 +20,136 @@ func init() {
 	buffer = stdres.Buffer{}
 }
 
-var featureRegexp = regexp.MustCompile("^Feature: (?P<name>.+)$")
-var descriptionRegexp = regexp.MustCompile("^  ((?P<text>.+))?$")
-var scenarioRegexp = regexp.MustCompile("^  Scenario: (?P<description>[a-zA-Z ]+)")
-var stepRegexp = regexp.MustCompile("^    (?P<cmd>Given|When|Then|But|And) (?P<description>.+)$")
-var emptyLineRexexp = regexp.MustCompile("^[\t ]+$")
-
 // NewFeature scans FeatureFile for lines starting with
 // "Feature:" followed by feature name, description
 // and different scenarios. All scenarios including
 // description are then returned as a Feature.
-func NewFeature(reader io.Reader) (feature *Feature) {
-	scanner := bufio.NewScanner(reader)
+// NewFeature exits on parse errors, see ParseFeature.
+func NewFeature(reader io.Reader) *Feature {
+	path := ""
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		if featureRegexp.MatchString(line) {
-			feature = scanFeature(getArgs(featureRegexp, line), scanner)
-		} else {
-			fmt.Println(line)
-		}
+	if file, ok := reader.(*os.File); ok && file != os.Stdin {
+		path = file.Name()
 	}
 
-	if err := scanner.Err(); err != nil {
-		log.Fatal(scanner.Err())
+	feature, err := ParseFeature(path, reader)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	return
+	return feature
 }
 
-func scanFeature(regexpMap Args, scanner *bufio.Scanner) (feature *Feature) {
-	feature = &Feature{}
+// ParseFeature parses a feature file read from reader, path names the
+// file in locations and errors. Lines that aren't expected where they are
+// found are reported as ParseError, including location of the line.
+// Exactly one feature is expected, see ParseFeatures.
+//
+// Keywords are English, unless a "# language: sv" header preceding the
+// feature line selects another dialect, see package gherkin.
+func ParseFeature(path string, reader io.Reader) (*Feature, error) {
+	features, err := ParseFeatures(path, reader)
+	if err != nil {
+		return nil, err
+	} else if len(features) > 1 {
+		return nil, fmt.Errorf("%s: found %d features, expected one", features[1].Location, len(features))
+	}
 
-	feature.Name = regexpMap["name"]
-	feature.Description = ""
+	return features[0], nil
+}
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		if scenarioRegexp.MatchString(line) {
-			feature.Scenarios = append(feature.Scenarios, scanScenario(getArgs(scenarioRegexp, line), scanner))
-		} else if descriptionRegexp.MatchString(line) {
-			feature.Description += "\n" + line
-		} else {
-			feature.Description += "\n" + line
-		}
+// ParseFeatures parses all features read from reader, e.g. concatenated
+// feature files. Scenarios of each feature are compiled from pickles, see
+// gherkin.Compile, where steps of backgrounds precedes scenario steps and
+// scenario outlines are expanded to one scenario per examples row.
+func ParseFeatures(path string, reader io.Reader) ([]*Feature, error) {
+	documents, err := gherkin.Parse(path, reader)
+	if err != nil {
+		return nil, err
 	}
 
-	return
+	features := []*Feature{}
+
+	for _, document := range documents {
+		features = append(features, newFeature(document))
+	}
+
+	return features, nil
 }
 
-func getArgs(re *regexp.Regexp, line string) (regexpMap Args) {
-	regexpMap = Args{}
+// newFeature converts a Gherkin document to the Feature tested by suites.
+func newFeature(document *gherkin.GherkinDocument) *Feature {
+	location := func(l gherkin.Location) Location {
+		return Location{File: document.URI, Line: l.Line, Column: l.Column}
+	}
 
-	if matches := re.FindStringSubmatch(line); matches != nil {
-		for i, name := range re.SubexpNames() {
-			regexpMap[name] = matches[i]
-		}
+	feature := &Feature{
+		Location:    location(document.Feature.Location),
+		Name:        document.Feature.Name,
+		Description: document.Feature.Description,
+		Tags:        []string{},
 	}
 
-	return
-}
+	for _, tag := range document.Feature.Tags {
+		feature.Tags = append(feature.Tags, tag.Name)
+	}
 
-func scanScenario(regexpMap Args, scanner *bufio.Scanner) (scenario Scenario) {
-	scenario.Description = regexpMap["description"]
+	for _, pickle := range gherkin.Compile(document) {
+		scenario := Scenario{
+			Location:    location(pickle.Location),
+			Description: pickle.Name,
+			Text:        pickle.Scenario.Description,
+			Tags:        pickle.Tags,
+			block:       [2]int{pickle.Scenario.Line, pickle.Scenario.Line},
+		}
 
-	for scanner.Scan() {
-		line := scanner.Text()
+		if steps := pickle.Scenario.Steps; len(steps) > 0 {
+			scenario.block[1] = steps[len(steps)-1].Line
+		}
 
-		if emptyLineRexexp.MatchString(line) {
-			continue
-		} else if stepRegexp.MatchString(line) {
-			scenario.Steps = append(scenario.Steps, scanStep(getArgs(stepRegexp, line), scanner))
-		} else {
-			return
+		for _, pickleStep := range pickle.Steps {
+			step := Step{
+				Location:    location(pickleStep.Location),
+				Cmd:         pickleStep.Type,
+				Keyword:     strings.TrimSpace(pickleStep.Keyword),
+				Description: pickleStep.Text,
+			}
+
+			if docString := pickleStep.DocString; docString != nil {
+				step.DocString = &DocString{MediaType: docString.MediaType, Content: docString.Content}
+			}
+
+			if dataTable := pickleStep.DataTable; dataTable != nil {
+				step.DataTable = DataTable{}
+
+				for _, row := range dataTable.Rows {
+					cells := []string{}
+
+					for _, cell := range row.Cells {
+						cells = append(cells, cell.Value)
+					}
+
+					step.DataTable = append(step.DataTable, cells)
+				}
+			}
+
+			scenario.Steps = append(scenario.Steps, step)
 		}
+
+		feature.Scenarios = append(feature.Scenarios, scenario)
 	}
 
-	return
+	return feature
 }
 
-func scanStep(regexpMap Args, scanner *bufio.Scanner) (step Step) {
-	step.Description = regexpMap["description"]
-	step.Cmd = regexpMap["cmd"]
+func getArgs(re *regexp.Regexp, line string) (regexpMap Args) {
+	regexpMap = Args{}
+
+	if matches := re.FindStringSubmatch(line); matches != nil {
+		for i, name := range re.SubexpNames() {
+			regexpMap[name] = matches[i]
+		}
+	}
 
 	return
 }
@@ -119,48 +170,258 @@ func (ts *suite) Test(feature Feature, t *testing.T) error {
 }
 
 func (ts *suite) testFeature(feature Feature, t *testing.T) error {
-	ts.totalFeatures++
+	ts.count(&ts.totalFeatures)
 
 	featureText := buffer.Println(fmt.Sprintf("Feature: %s\n", feature.Name))
 	featureText.Result = stdres.SUCCESS // Assume succes until something else has been proven
+
+	if ts.settings.Feature != "" {
+		feature = feature.locate(ts.settings.Feature)
+	}
+
+	feature.Scenarios = ts.selectScenarios(feature.Scenarios)
+	ts.shuffle(len(feature.Scenarios), func(i, j int) {
+		feature.Scenarios[i], feature.Scenarios[j] = feature.Scenarios[j], feature.Scenarios[i]
+	})
+
+	// Undefined steps and attempts of earlier features are already written to files
+	undefined, attempts := len(ts.undefined), len(ts.attempts)
+	outputs := make([]stdres.Buffer, len(feature.Scenarios)) // Output isolated per scenario
+	results := make([]error, len(feature.Scenarios))
+
 	defer func() {
+		buffer.Flush()
+
+		for i := range outputs {
+			outputs[i].Flush() // Flushed in order of definition, regardless of execution order
+		}
+
 		buffer.Println(ts.String()).Result = stdres.PLAIN
+
+		if failing := failingScenarios(feature.Scenarios, results); failing != "" {
+			buffer.Println("\n    Failing scenarios:\n" + failing).Result = stdres.FAILURE
+		}
+
 		buffer.Println("\n    You can implement step definition for undefined steps with these snippets:").Result = stdres.PLAIN
 		buffer.Println(ts.snippets()).Result = stdres.INFO
 		buffer.Flush()
 	}()
 
-	for _, scenario := range feature.Scenarios {
-		err := ts.testScenario(scenario)
+	ts.schedule(feature, func(i int, scenario Scenario) {
+		if ts.stopped() {
+			results[i] = ts.skipScenario(scenario, &outputs[i])
+			return
+		}
+
+		results[i] = ts.retryScenario(scenario, ts.retries(scenario), &outputs[i])
+
+		if ts.settings.FailFast && isFailure(results[i]) {
+			ts.stop() // Remaining scenarios are skipped
+		}
+	})
 
+	for _, err := range results {
 		switch err.(type) {
 		case nil:
-			ts.successFeatures++
+			ts.count(&ts.successFeatures)
 		case PendingError:
-			ts.pendingFeatures++
+			ts.count(&ts.pendingFeatures)
 			featureText.Result = stdres.PENDING
-		case NotImplError:
+		case NotImplError, skippedError:
 			featureText.Result = stdres.UNKNOWN
+		case AmbiguousError:
+			featureText.Result = stdres.FAILURE
 		default:
-			ts.failuresFeatures++
+			ts.count(&ts.failuresFeatures)
 			featureText.Result = stdres.FAILURE
 		}
+
+		if _, undefined := err.(NotImplError); isFailure(err) || undefined && ts.settings.DryRun {
+			t.Fail() // Reported by generated binaries exit code
+		}
 	}
 
+	ts.writeRerun(feature.Scenarios, results)
+	ts.writeSnippets(undefined)
+	ts.writeAttempts(attempts)
+
 	return nil
 }
 
-func (ts *suite) testScenario(scenario Scenario) error {
-	var notimplemented, pending, failure bool // TODO: We are not able to identify not implemented scenarios(?)
+// selectScenarios returns a copy of scenarios, limited to
+// those containing one of the lines in settings, if any.
+func (ts *suite) selectScenarios(scenarios []Scenario) []Scenario {
+	selected := []Scenario{}
+
+	name, err := regexp.Compile(ts.settings.Name)
+	if err != nil {
+		log.Fatalf("Invalid scenario name filter %q: %s", ts.settings.Name, err)
+	}
+
+	for _, scenario := range scenarios {
+		if !name.MatchString(scenario.Description) {
+			continue
+		}
+
+		if len(ts.settings.Lines) == 0 {
+			selected = append(selected, scenario)
+			continue
+		}
+
+		for _, line := range ts.settings.Lines {
+			if scenario.contains(line) {
+				selected = append(selected, scenario)
+				break
+			}
+		}
+	}
+
+	return selected
+}
+
+// writeRerun appends location of failed scenarios to rerun file, if configured by settings.
+// Scenarios with undefined steps are included, the run failed until they are defined.
+func (ts *suite) writeRerun(scenarios []Scenario, results []error) {
+	if ts.settings.Rerun == "" {
+		return
+	}
+
+	rerun := ""
+
+	for i, err := range results {
+		if _, undefined := err.(NotImplError); isFailure(err) || undefined {
+			rerun += fmt.Sprintf("%s\n", scenarios[i].Location)
+		}
+	}
+
+	file, err := os.OpenFile(ts.settings.Rerun, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) // #nosec
+	if err != nil {
+		log.Printf("Error opening rerun file: %s", err)
+		return
+	}
+
+	defer file.Close()
+
+	if _, err := file.WriteString(rerun); err != nil {
+		log.Printf("Error writing rerun file: %s", err)
+	}
+}
+
+// writeSnippets appends undefined steps, starting at index from, to snippets file
+// as lines of JSON, if configured by settings.
+func (ts *suite) writeSnippets(from int) {
+	if ts.settings.Snippets == "" {
+		return
+	}
+
+	file, err := os.OpenFile(ts.settings.Snippets, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) // #nosec
+	if err != nil {
+		log.Printf("Error opening snippets file: %s", err)
+		return
+	}
+
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+
+	for _, step := range ts.undefined[from:] {
+		if err := encoder.Encode(step); err != nil {
+			log.Printf("Error writing snippets file: %s", err)
+			return
+		}
+	}
+}
+
+// writeAttempts appends attempts of retried scenarios, starting at index from, to
+// attempts file as lines of JSON, if configured by settings.
+func (ts *suite) writeAttempts(from int) {
+	if ts.settings.Attempts == "" {
+		return
+	}
+
+	file, err := os.OpenFile(ts.settings.Attempts, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) // #nosec
+	if err != nil {
+		log.Printf("Error opening attempts file: %s", err)
+		return
+	}
+
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+
+	for _, attempt := range ts.attempts[from:] {
+		if err := encoder.Encode(attempt); err != nil {
+			log.Printf("Error writing attempts file: %s", err)
+			return
+		}
+	}
+}
+
+// failingScenarios lists locations of failed scenarios, one per line. Empty
+// if no scenario failed, or if the path of the feature file is unknown.
+func failingScenarios(scenarios []Scenario, results []error) string {
+	failing := ""
+
+	for i, err := range results {
+		if isFailure(err) && scenarios[i].File != "" {
+			failing += fmt.Sprintf("      %s # Scenario: %s\n", scenarios[i].Location, scenarios[i].Description)
+		}
+	}
+
+	return strings.TrimSuffix(failing, "\n")
+}
+
+// printLocation prints location of a failed step, if the path of the feature file is known.
+func printLocation(location Location, out *stdres.Buffer) {
+	if location.File != "" {
+		out.Println("      at " + location.String()).Result = stdres.FAILURE
+	}
+}
+
+// isFailure reports if err, returned by testScenario, fails the scenario.
+// Pending, undefined and skipped scenarios are not regarded as failures.
+func isFailure(err error) bool {
+	switch err.(type) {
+	case nil, PendingError, NotImplError, skippedError:
+		return false
+	}
+
+	return true
+}
+
+// skipScenario records scenario and its steps as skipped, without testing them.
+func (ts *suite) skipScenario(scenario Scenario, out *stdres.Buffer) error {
+	out.Println(fmt.Sprintf("  Scenario: %s (skipped)\n", scenario.Description)).Result = stdres.UNKNOWN
+
+	ts.count(&ts.totalScenarios)
+	ts.count(&ts.skippedScenarios)
+
+	for range scenario.Steps {
+		ts.count(&ts.totalSteps)
+		ts.count(&ts.skippedSteps)
+	}
+
+	return skippedError{}
+}
+
+func (ts *suite) testScenario(scenario Scenario, out *stdres.Buffer) error {
+	var notimplemented, pending, failure, ambiguous bool // TODO: We are not able to identify not implemented scenarios(?)
 	var result error
 
-	scenarioText := buffer.Println(fmt.Sprintf("  Scenario: %s\n", scenario.Description))
+	scenarioText := out.Println(fmt.Sprintf("  Scenario: %s\n", scenario.Description))
 	scenarioText.Result = stdres.UNKNOWN
-	ts.totalScenarios++
+	ts.count(&ts.totalScenarios)
+
+	keyword := "" // Primary keyword, And, But and * steps are resolved to
+	ctx := newContext(scenario)
 
 	for _, step := range scenario.Steps {
-		optout := notimplemented || pending || failure
-		err := ts.testStep(step, optout)
+		if !isConjunction(step.Cmd) {
+			keyword = step.Cmd
+		}
+
+		optout := notimplemented || pending || failure || ambiguous
+		err := ts.testStep(ctx, step, keyword, optout, out)
 
 		switch e := err.(type) {
 		case nil:
@@ -170,7 +431,13 @@ func (ts *suite) testScenario(scenario Scenario) error {
 		case NotImplError:
 			notimplemented = true
 
-			ts.missingImpl[e.snippet()] = true
+			ts.addSnippet(e)
+		case AmbiguousError:
+			ambiguous = true
+
+			if result == nil {
+				result = e
+			}
 		default:
 			// Handle "real" errors
 			failure = true
@@ -184,35 +451,65 @@ func (ts *suite) testScenario(scenario Scenario) error {
 
 	if failure {
 		scenarioText.Result = stdres.FAILURE
-		ts.failuresScenarios++
+		ts.count(&ts.failuresScenarios)
+
+		return result
+	} else if ambiguous {
+		scenarioText.Result = stdres.FAILURE
+		ts.count(&ts.ambiguousScenarios)
 
 		return result
 	} else if pending {
 		scenarioText.Result = stdres.PENDING
-		ts.pendingScenarios++
+		ts.count(&ts.pendingScenarios)
 
 		return Pending("")
 	} else if notimplemented { // TODO: We are not able to identify not implemented scenarios(?)
 		scenarioText.Result = stdres.UNKNOWN
-		ts.undefinedScenarios++
+		ts.count(&ts.undefinedScenarios)
 
 		return NotImplError{}
 	}
 
+	if ts.settings.DryRun {
+		scenarioText.Result = stdres.UNKNOWN
+		ts.count(&ts.skippedScenarios)
+
+		return skippedError{}
+	}
+
 	scenarioText.Result = stdres.SUCCESS
-	ts.successScenarios++
+	ts.count(&ts.successScenarios)
 
 	return nil
 }
 
-func (ts *suite) testStep(step Step, optout bool) error {
-	text := buffer.Println(fmt.Sprintf("    %s %s", step.Cmd, step.Description))
+// printArgument prints doc string or data table following step, if any.
+func printArgument(step Step, out *stdres.Buffer) {
+	if step.DocString != nil {
+		out.Println(`      """` + step.DocString.MediaType).Result = stdres.INFO
+
+		for _, line := range strings.Split(step.DocString.Content, "\n") {
+			out.Println("      " + line).Result = stdres.INFO
+		}
+
+		out.Println(`      """`).Result = stdres.INFO
+	}
+
+	for _, row := range step.DataTable {
+		out.Println("      | " + strings.Join(row, " | ") + " |").Result = stdres.INFO
+	}
+}
+
+func (ts *suite) testStep(ctx *Context, step Step, keyword string, optout bool, out *stdres.Buffer) error {
+	text := out.Println("    " + step.String())
 	text.Result = stdres.UNKNOWN
+	printArgument(step, out)
 	defer func() {
-		buffer.Println("").Result = stdres.INFO
+		out.Println("").Result = stdres.INFO
 	}()
 
-	ts.totalSteps++
+	ts.count(&ts.totalSteps)
 
 	if optout {
 		defer func() {
@@ -220,31 +517,87 @@ func (ts *suite) testStep(step Step, optout bool) error {
 		}()
 	}
 
-	for _, impl := range stepRegister {
-		match, err := impl(step.Description, optout)
+	matches := []*stepDefinition{}
+	arguments := []stepArguments{}
+	mismatches := []string{} // Definitions matching text, but not keyword
 
-		if !match {
+	for _, definition := range stepLookup.candidates(step.Description) {
+		if args, ok := definition.expr.match(step.Description); !ok {
 			continue
+		} else if ts.settings.StrictKeywords && !definition.accepts(keyword) {
+			mismatches = append(mismatches, definition.String())
+		} else {
+			matches = append(matches, definition)
+			arguments = append(arguments, args)
 		}
+	}
 
-		switch err.(type) {
-		case nil:
-			if optout {
-				ts.optoutSteps++
-			} else {
-				ts.successSteps++
-			}
-		case PendingError:
-			ts.pendingSteps++
-			text.Result = stdres.PENDING
-		default:
-			ts.failuresSteps++
-			text.Result = stdres.FAILURE
+	if len(matches) > 1 {
+		candidates := []string{}
+
+		for _, definition := range matches {
+			candidates = append(candidates, definition.String())
+		}
+
+		ts.count(&ts.ambiguousSteps)
+		text.Result = stdres.FAILURE
+		out.Println("      Ambiguous step, matching step definitions:\n        " + strings.Join(candidates, "\n        ")).Result = stdres.FAILURE
+		printLocation(step.Location, out)
+
+		return Ambiguous(step, candidates)
+	} else if len(matches) == 0 {
+		if len(mismatches) > 0 {
+			out.Println(fmt.Sprintf("      Undefined %s step, matching step definitions with other keywords:\n        %s", keyword, strings.Join(mismatches, "\n        "))).Result = stdres.UNKNOWN
+		}
+
+		if isConjunction(step.Cmd) {
+			step.Cmd = keyword // Snippet shall register definition for resolved keyword
+		}
+
+		if step.Cmd == "" {
+			step.Cmd = "Given" // Conjunction without preceding keyword
 		}
 
-		return err
+		ts.count(&ts.undefinedSteps)
+		return NotImplError{t: step, style: ts.settings.SnippetStyle}
 	}
 
-	ts.undefinedSteps++
-	return NotImplemented(step)
+	var err error
+
+	if step.DocString != nil {
+		arguments[0].values = append(arguments[0].values, argument{step: *step.DocString})
+	} else if step.DataTable != nil {
+		arguments[0].values = append(arguments[0].values, argument{step: step.DataTable})
+	}
+
+	if !optout && !ts.settings.DryRun {
+		arguments[0].ctx = ctx
+		err = matches[0].run(arguments[0])
+	}
+
+	switch err.(type) {
+	case nil:
+		if ts.settings.DryRun {
+			ts.count(&ts.skippedSteps)
+		} else if optout {
+			ts.count(&ts.optoutSteps)
+		} else {
+			ts.count(&ts.successSteps)
+		}
+	case PendingError:
+		ts.count(&ts.pendingSteps)
+		text.Result = stdres.PENDING
+	default:
+		ts.count(&ts.failuresSteps)
+		text.Result = stdres.FAILURE
+		out.Println(indent(err.Error(), "      ")).Result = stdres.FAILURE
+		printLocation(step.Location, out)
+	}
+
+	return err
+}
+
+// indent prefixes each line in text with prefix.
+func indent(text, prefix string) string {
+	return prefix + strings.Replace(text, "\n", "\n"+prefix, -1)
 }
@@ -2,6 +2,7 @@ package unbrokenwing_test
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"strings"
 	"testing"
@@ -79,11 +80,11 @@ Feature: Manage users
 	//
 	//     You can implement step definition for undefined steps with these snippets:
 	//
-	//     And("^user hacker should have password changeme$", func(args Args) error {
+	//     Then("^only one user-record with name hacker should exist$", func(args Args) error {
 	//         return Pending("Not implemented")
 	//     })
 	//
-	//     Then("^only one user-record with name hacker should exist$", func(args Args) error {
+	//     Then("^user hacker should have password changeme$", func(args Args) error {
 	//         return Pending("Not implemented")
 	//     })
 	//
@@ -91,3 +92,321 @@ Feature: Manage users
 	//         return Pending("Not implemented")
 	//     })
 }
+
+func ExampleSuite_Test_ambiguous() {
+	stdres.DisableColor()
+
+	Given("^I have (\\d+) apples in my basket$", func(args Args) error { return nil })
+	Given("I have {int} apples in my basket", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Ambiguous steps
+
+  Scenario: Count apples
+    Given I have 3 apples in my basket
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuite()
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Ambiguous steps
+	//
+	//   Scenario: Count apples
+	//
+	//     Given I have 3 apples in my basket
+	//       Ambiguous step, matching step definitions:
+	//         Given("^I have (\d+) apples in my basket$") (driver_test.go:99)
+	//         Given("I have {int} apples in my basket") (driver_test.go:100)
+	//
+	//     1 scenario (0 undefined, 0 failures, 0 pending, 1 ambiguous)
+	//     1 steps (0 undefined, 0 failures, 0 pending, 0 optout, 1 ambiguous)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+}
+
+func ExampleSuite_Test_panic() {
+	stdres.DisableColor()
+
+	Given("a nil map of colors", func(args Args) error { return nil })
+	When("I paint the fence {word}", func(color string) error {
+		var colors map[string]bool
+		colors[color] = true
+		return nil
+	})
+	Then("the fence is painted", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Panicking steps
+
+  Scenario: Paint a fence
+    Given a nil map of colors
+    When I paint the fence blue
+    Then the fence is painted
+
+  Scenario: Look at the fence
+    Then the fence is painted
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuite()
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Panicking steps
+	//
+	//   Scenario: Paint a fence
+	//
+	//     Given a nil map of colors
+	//
+	//     When I paint the fence blue
+	//       panic: assignment to entry in nil map
+	//       gomate.io/gomate/unbrokenwing_test.ExampleSuite_Test_panic.func2
+	//       	driver_test.go:137
+	//
+	//     Then the fence is painted
+	//
+	//   Scenario: Look at the fence
+	//
+	//     Then the fence is painted
+	//
+	//     2 scenario (0 undefined, 1 failures, 0 pending)
+	//     4 steps (0 undefined, 1 failures, 0 pending, 1 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+}
+
+func ExampleNewFeature_location() {
+	buffer := bytes.NewBufferString(`
+Feature: Locations
+
+  Scenario: Create account
+    Given an empty database
+    When I create an account
+`)
+
+	feature := NewFeature(buffer)
+
+	fmt.Println(feature.Location, feature.Column)
+
+	for _, scenario := range feature.Scenarios {
+		fmt.Println(scenario.Location, scenario.Column)
+
+		for _, step := range scenario.Steps {
+			fmt.Println(step.Location, step.Column)
+		}
+	}
+
+	// Output:
+	// line 2 1
+	// line 4 3
+	// line 5 5
+	// line 6 5
+}
+
+func ExampleParseFeature_errors() {
+	for _, text := range []string{
+		"Feature: Accounts\n\n  Scenario: Create account\n    Given an empty database\n    Whenever I create an account\n",
+		"# Accounts\n\nScenario: Create account\n",
+		"Feature: Accounts\n\n  @admin\n",
+	} {
+		_, err := ParseFeature("features/create.feature", bytes.NewBufferString(text))
+		fmt.Println(err)
+	}
+
+	// Output:
+	// features/create.feature:5:5: unexpected "Whenever I create an account", step keyword expected
+	// features/create.feature:3:1: unexpected "Scenario: Create account", Feature: or tag expected
+	// features/create.feature:4:1: unexpected end of file, Scenario: or Rule: expected
+}
+
+func ExampleParseFeature_indentation() {
+	text := "Feature:\tAccounts\n" +
+		"\tAccounts are created by administrators.\n" +
+		"\n" +
+		"Scenario: Create account\n" +
+		"\t\tOnly administrators may create accounts.\n" +
+		"Given an empty database\n" +
+		"\t  When   I create an account  \n"
+
+	feature, err := ParseFeature("", bytes.NewBufferString(text))
+	if err != nil {
+		panic(err)
+	}
+
+	scenario := feature.Scenarios[0]
+
+	fmt.Printf("%q\n%q\n%q\n", feature.Name, feature.Description, scenario.Text)
+
+	for _, step := range scenario.Steps {
+		fmt.Printf("%s %q (%s, column %d)\n", step.Cmd, step.Description, step.Location, step.Column)
+	}
+
+	// Output:
+	// "Accounts"
+	// "\tAccounts are created by administrators."
+	// "\t\tOnly administrators may create accounts."
+	// Given "an empty database" (line 6, column 1)
+	// When "I create an account" (line 7, column 4)
+}
+
+func ExampleSuite_Test_rules() {
+	stdres.DisableColor()
+
+	Given("a café with {int} tables", func(tables int) error { return nil })
+	Given("the café is open", func(args Args) error { return nil })
+	When("{int} guests arrive", func(guests int) error { return nil })
+	Then("every guest gets a table", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Seating
+
+  Background:
+    Given a café with 4 tables
+
+  Rule: Guests are seated while open
+
+    Background:
+      * the café is open
+
+    # Groups with more guests than tables are not covered yet
+    Example: Seat 2½ couples, i.e. 5 guests
+      When 5 guests arrive
+      * every guest gets a table
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Seating
+	//
+	//   Scenario: Seat 2½ couples, i.e. 5 guests
+	//
+	//     Given a café with 4 tables
+	//
+	//     * the café is open
+	//
+	//     When 5 guests arrive
+	//
+	//     * every guest gets a table
+	//
+	//     1 scenario (0 undefined, 0 failures, 0 pending)
+	//     4 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+}
+
+func ExampleParseFeature_language() {
+	stdres.DisableColor()
+
+	Given("ett tomt lager", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`# language: sv
+Egenskap: Lager
+
+  Scenario: Leverans
+    Givet ett tomt lager
+    När 3 lådor levereras
+    Så innehåller lagret 3 lådor
+`)
+
+	feature, err := ParseFeature("", buffer)
+	if err != nil {
+		panic(err)
+	}
+
+	suite := NewSuiteWithSettings(Settings{})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Lager
+	//
+	//   Scenario: Leverans
+	//
+	//     Givet ett tomt lager
+	//
+	//     När 3 lådor levereras
+	//
+	//     Så innehåller lagret 3 lådor
+	//
+	//     1 scenario (1 undefined, 0 failures, 0 pending)
+	//     3 steps (2 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	//     Then("^innehåller lagret (?P<arg1>-?[0-9]+) lådor$", func(arg1 int) error {
+	//         return Pending("Not implemented")
+	//     })
+	//
+	//     When("^(?P<arg1>-?[0-9]+) lådor levereras$", func(arg1 int) error {
+	//         return Pending("Not implemented")
+	//     })
+}
+
+func ExampleSuite_Test_outline() {
+	stdres.DisableColor()
+
+	Given("a mail titled {string}:", func(title string, body string) error {
+		fmt.Printf("%s: %q\n", title, body)
+		return nil
+	})
+
+	Then("the inbox contains:", func(table DataTable) error {
+		fmt.Println(table)
+		return nil
+	})
+
+	buffer := bytes.NewBufferString(`
+Feature: Inbox
+
+  Scenario Outline: Receive mail from <sender>
+    Given a mail titled "<title>":
+      """
+      Hi,
+      regards <sender>
+      """
+    Then the inbox contains:
+      | sender   | title   |
+      | <sender> | <title> |
+
+    Examples:
+      | sender | title |
+      | Alice  | Hello |
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Hello: "Hi,\nregards Alice"
+	// [[sender title] [Alice Hello]]
+	// Feature: Inbox
+	//
+	//   Scenario: Receive mail from Alice
+	//
+	//     Given a mail titled "Hello":
+	//       """
+	//       Hi,
+	//       regards Alice
+	//       """
+	//
+	//     Then the inbox contains:
+	//       | sender | title |
+	//       | Alice | Hello |
+	//
+	//     1 scenario (0 undefined, 0 failures, 0 pending)
+	//     2 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+}
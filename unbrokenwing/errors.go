@@ -2,7 +2,9 @@ package unbrokenwing
 
 import (
 	"fmt"
-	"regexp"
+	"strings"
+
+	"gomate.io/gomate/gherkin"
 )
 
 // FailureError are suitable to be
@@ -46,27 +48,23 @@ func Pending(reason string) error {
 // NotImplError are suitable to be returned
 // when unbrokenwings driver are not able
 // to find matching behaviour implementation.
-type NotImplError struct{ t Step }
+// Style of its snippet is configured by Settings.
+type NotImplError struct {
+	t     Step
+	style string
+}
 
 // Generates a behaviour snippet
 // matching missing implementation.
-func (e NotImplError) snippet() string {
-	t := e.t
-
-	r := regexp.MustCompile("\"([0-9+-]+)\"")
-	newRe := r.ReplaceAllString(t.Description, "\\\"([0-9+-]+)\\\"")
-
-	snippet := fmt.Sprintf(`
-    %s("^%s$", func(args Args) error {
-        return Pending("Not implemented")
-    })`, t.Cmd, newRe)
+func (e NotImplError) snippet(style string) string {
+	_, code := e.definition(style)
 
-	return snippet
+	return "\n" + indent(strings.Replace(code, "\t", "    ", -1), "    ")
 }
 
 func (e NotImplError) Error() string {
 	intro := "You can implement step definition with following snippet:"
-	return fmt.Sprintf("Not Implemented: %s\n%s\n%s", e.t, intro, e.snippet())
+	return fmt.Sprintf("Not Implemented: %s (%s)\n%s\n%s", e.t, e.t.Location, intro, e.snippet(e.style))
 }
 
 // NotImplemented returns error that
@@ -76,3 +74,35 @@ func (e NotImplError) Error() string {
 func NotImplemented(t Step) error {
 	return NotImplError{t: t}
 }
+
+// AmbiguousError are suitable to be returned
+// when unbrokenwings driver finds more than one
+// behaviour implementation matching a step.
+type AmbiguousError struct {
+	t          Step
+	candidates []string
+}
+
+func (e AmbiguousError) Error() string {
+	return fmt.Sprintf("Ambiguous: %s (%s)\nMatching step definitions:\n    %s", e.t, e.t.Location, strings.Join(e.candidates, "\n    "))
+}
+
+// Ambiguous returns error that are suitable to be
+// returned when unbrokenwings driver finds more than
+// one behaviour implementation matching a step.
+// Candidates describes each matching implementation.
+func Ambiguous(t Step, candidates []string) error {
+	return AmbiguousError{t: t, candidates: candidates}
+}
+
+// ParseError reports a line in a feature file, which
+// isn't expected where it is found, see gherkin.ParseError.
+type ParseError = gherkin.ParseError
+
+// skippedError are returned for scenarios not
+// tested at all, e.g. due to fail fast mode.
+type skippedError struct{}
+
+func (e skippedError) Error() string {
+	return "Skipped"
+}
@@ -0,0 +1,25 @@
+package unbrokenwing
+
+import "fmt"
+
+func ExampleNotImplError_Error() {
+	step := Step{Location: Location{File: "features/cart.feature", Line: 4}, Cmd: "Given", Description: "a cart with 3 items"}
+
+	for _, style := range []string{RegexpSnippets, CucumberSnippets} {
+		fmt.Println(NotImplError{t: step, style: style})
+	}
+
+	// Output:
+	// Not Implemented: Given a cart with 3 items (features/cart.feature:4)
+	// You can implement step definition with following snippet:
+	//
+	//     Given("^a cart with (?P<arg1>-?[0-9]+) items$", func(arg1 int) error {
+	//         return Pending("Not implemented")
+	//     })
+	// Not Implemented: Given a cart with 3 items (features/cart.feature:4)
+	// You can implement step definition with following snippet:
+	//
+	//     Given("a cart with {int} items", func(arg1 int) error {
+	//         return Pending("Not implemented")
+	//     })
+}
@@ -0,0 +1,305 @@
+package unbrokenwing
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// expression matches step descriptions against the pattern supplied
+// to one of the step definition functions, e.g. Given or When.
+type expression interface {
+	// match returns arguments extracted from text, and false
+	// if text doesn't match the expression.
+	match(text string) (stepArguments, bool)
+
+	// prefix returns literal text that must begin any matching text.
+	prefix() string
+}
+
+// stepArguments holds arguments extracted from a step description,
+// both as Args and as ordered values for typed step definitions, and
+// the context of the scenario the step belongs to.
+type stepArguments struct {
+	args   Args
+	values []argument
+	ctx    *Context
+}
+
+// argument is a value captured by an expression. Parameter
+// is nil for groups captured by regular expressions. Step holds
+// the DocString or DataTable following a step, instead of value.
+type argument struct {
+	value     string
+	parameter *parameterType
+	step      interface{}
+}
+
+// regexpSyntax matches syntax of regular expressions without meaning in Cucumber
+// Expressions: character class escapes e.g. "\d", bracket expressions, repeated
+// groups, classes or wildcards, group flags, "|" alternations and "{n,m}" repeats.
+var regexpSyntax = regexp.MustCompile(`\\[dDwWsSbB]|\[|[.)\]][*+?]|\(\?|\||\{[0-9]+(?:,[0-9]*)?\}`)
+
+// newExpression compiles pattern either as a regular expression or as a
+// Cucumber Expression. Patterns anchored with "^" or "$", or using regular
+// expression syntax, see regexpSyntax, are regular expressions. Everything
+// else is treated as a Cucumber Expression.
+func newExpression(pattern string) (expression, error) {
+	if isRegexp(pattern) {
+		r, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		return regexpExpression{r}, nil
+	}
+
+	return newCucumberExpression(pattern)
+}
+
+func isRegexp(pattern string) bool {
+	return strings.HasPrefix(pattern, "^") || strings.HasSuffix(pattern, "$") || regexpSyntax.MatchString(pattern)
+}
+
+type regexpExpression struct {
+	r *regexp.Regexp
+}
+
+func (e regexpExpression) prefix() string {
+	return literalPrefix(e.r)
+}
+
+func (e regexpExpression) match(text string) (stepArguments, bool) {
+	matches := e.r.FindStringSubmatch(text)
+	if matches == nil {
+		return stepArguments{}, false
+	}
+
+	values := []argument{}
+
+	for _, value := range matches[1:] {
+		values = append(values, argument{value: value})
+	}
+
+	return stepArguments{args: getArgs(e.r, text), values: values}, true
+}
+
+// cucumberExpression is a compiled Cucumber Expression, see
+// https://github.com/cucumber/cucumber-expressions for syntax.
+//
+// Parameters are passed to the step definition in Args, keyed
+// by their position in the expression starting at "1".
+type cucumberExpression struct {
+	r          *regexp.Regexp
+	parameters []*parameterType
+	groups     []int // Capturing group index for each parameter
+	nested     []int // Number of capturing groups inside each parameter
+}
+
+func (e cucumberExpression) prefix() string {
+	return literalPrefix(e.r)
+}
+
+func (e cucumberExpression) match(text string) (stepArguments, bool) {
+	matches := e.r.FindStringSubmatchIndex(text)
+	if matches == nil {
+		return stepArguments{}, false
+	}
+
+	arguments := stepArguments{args: Args{}}
+
+	for i, group := range e.groups {
+		value := groupValue(text, matches, group, e.nested[i])
+
+		arguments.args[strconv.Itoa(i+1)] = value
+		arguments.values = append(arguments.values, argument{value: value, parameter: e.parameters[i]})
+	}
+
+	return arguments, true
+}
+
+// groupValue returns the text captured by group, or by the first
+// participating group nested inside it when there are any.
+func groupValue(text string, matches []int, group, nested int) string {
+	for g := group + 1; g <= group+nested; g++ {
+		if matches[2*g] >= 0 {
+			return text[matches[2*g]:matches[2*g+1]]
+		}
+	}
+
+	if matches[2*group] < 0 {
+		return ""
+	}
+
+	return text[matches[2*group]:matches[2*group+1]]
+}
+
+type tokenKind int
+
+const (
+	textToken tokenKind = iota
+	whitespaceToken
+	alternationToken
+	optionalToken
+	parameterToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func newCucumberExpression(pattern string) (expression, error) {
+	tokens, err := tokenizeExpression(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("cucumber expression %q: %s", pattern, err)
+	}
+
+	e := cucumberExpression{}
+	source := "^"
+	group := 1
+
+	for len(tokens) > 0 {
+		var word []token
+
+		switch tokens[0].kind {
+		case whitespaceToken:
+			source += regexp.QuoteMeta(tokens[0].text)
+			tokens = tokens[1:]
+			continue
+		case parameterToken:
+			p, ok := parameterTypes[tokens[0].text]
+			if !ok {
+				return nil, fmt.Errorf("cucumber expression %q: undefined parameter type {%s}", pattern, tokens[0].text)
+			}
+
+			r := regexp.MustCompile(p.regexp) // Validated by ParameterType
+
+			source += "((?:" + p.regexp + "))"
+			e.parameters = append(e.parameters, p)
+			e.groups = append(e.groups, group)
+			e.nested = append(e.nested, r.NumSubexp())
+			group += 1 + r.NumSubexp()
+			tokens = tokens[1:]
+			continue
+		}
+
+		// Collect a word, i.e. text delimited by whitespace or parameters
+		for len(tokens) > 0 && tokens[0].kind != whitespaceToken && tokens[0].kind != parameterToken {
+			word = append(word, tokens[0])
+			tokens = tokens[1:]
+		}
+
+		alternatives, err := compileWord(word)
+		if err != nil {
+			return nil, fmt.Errorf("cucumber expression %q: %s", pattern, err)
+		}
+
+		source += alternatives
+	}
+
+	r, err := regexp.Compile(source + "$")
+	if err != nil {
+		return nil, fmt.Errorf("cucumber expression %q: %s", pattern, err)
+	}
+
+	e.r = r
+
+	return e, nil
+}
+
+// compileWord translates text, optional text and alternations
+// between them into a regular expression.
+func compileWord(word []token) (string, error) {
+	alternatives := []string{""}
+
+	for _, t := range word {
+		last := len(alternatives) - 1
+
+		switch t.kind {
+		case textToken:
+			alternatives[last] += regexp.QuoteMeta(t.text)
+		case optionalToken:
+			alternatives[last] += "(?:" + regexp.QuoteMeta(t.text) + ")?"
+		case alternationToken:
+			alternatives = append(alternatives, "")
+		}
+	}
+
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+
+	for _, alternative := range alternatives {
+		if alternative == "" {
+			return "", errors.New("alternative may not be empty")
+		}
+	}
+
+	return "(?:" + strings.Join(alternatives, "|") + ")", nil
+}
+
+// tokenizeExpression splits a Cucumber Expression into text, whitespace,
+// alternation, optional text and parameter tokens. Backslash escapes
+// the special characters "{", "}", "(", ")", "/" and "\".
+func tokenizeExpression(pattern string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(pattern)
+
+	appendText := func(kind tokenKind, r rune) {
+		if last := len(tokens) - 1; last >= 0 && tokens[last].kind == kind {
+			tokens[last].text += string(r)
+		} else {
+			tokens = append(tokens, token{kind, string(r)})
+		}
+	}
+
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '\\':
+			if i+1 == len(runes) {
+				return nil, errors.New("escape character at end of expression")
+			}
+
+			i++
+			appendText(textToken, runes[i])
+		case r == ' ' || r == '\t':
+			appendText(whitespaceToken, r)
+		case r == '/':
+			tokens = append(tokens, token{alternationToken, "/"})
+		case r == '{' || r == '(':
+			closing := map[rune]rune{'{': '}', '(': ')'}[r]
+			text := ""
+
+			for i++; i < len(runes) && runes[i] != closing; i++ {
+				if strings.ContainsRune("{}()/", runes[i]) {
+					return nil, fmt.Errorf("unexpected %q inside %q", runes[i], r)
+				} else if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+
+				text += string(runes[i])
+			}
+
+			if i == len(runes) {
+				return nil, fmt.Errorf("missing %q", closing)
+			}
+
+			if r == '{' {
+				tokens = append(tokens, token{parameterToken, text})
+			} else if text == "" {
+				return nil, errors.New("optional text may not be empty")
+			} else {
+				tokens = append(tokens, token{optionalToken, text})
+			}
+		case r == '}' || r == ')':
+			return nil, fmt.Errorf("unexpected %q", r)
+		default:
+			appendText(textToken, r)
+		}
+	}
+
+	return tokens, nil
+}
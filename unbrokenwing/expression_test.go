@@ -0,0 +1,96 @@
+package unbrokenwing_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/dekelund/stdres"
+	. "gomate.io/gomate/unbrokenwing"
+)
+
+func ExampleGiven_cucumberExpression() {
+	stdres.DisableColor()
+
+	Given("I have {int} cukes in my belly/stomach", func(args Args) error {
+		fmt.Printf("cukes: %s\n", args["1"])
+		return nil
+	})
+	When("I eat {float} cucumber(s) named {string}", func(args Args) error {
+		fmt.Printf("eaten: %s %s\n", args["1"], args["2"])
+		return nil
+	})
+	Then("my {word} should be {}", func(args Args) error {
+		fmt.Printf("%s: %s\n", args["1"], args["2"])
+		return nil
+	})
+
+	buffer := bytes.NewBufferString(`
+Feature: Eat cucumbers
+
+  Scenario: Eat a cucumber
+    Given I have 42 cukes in my stomach
+    When I eat 1.5 cucumbers named "Cuke Norris"
+    Then my belly should be happy
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuite()
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// cukes: 42
+	// eaten: 1.5 Cuke Norris
+	// belly: happy
+	// Feature: Eat cucumbers
+	//
+	//   Scenario: Eat a cucumber
+	//
+	//     Given I have 42 cukes in my stomach
+	//
+	//     When I eat 1.5 cucumbers named "Cuke Norris"
+	//
+	//     Then my belly should be happy
+	//
+	//     1 scenario (0 undefined, 0 failures, 0 pending)
+	//     3 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+}
+
+func ExampleGiven_unanchoredRegexp() {
+	stdres.DisableColor()
+
+	Given(`I have (\d+) items? in my basket`, func(items int) error {
+		fmt.Printf("items: %d\n", items)
+		return nil
+	})
+
+	buffer := bytes.NewBufferString(`
+Feature: Fill basket
+
+  Scenario: Add items
+    Given I have 3 items in my basket
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuite()
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// items: 3
+	// Feature: Fill basket
+	//
+	//   Scenario: Add items
+	//
+	//     Given I have 3 items in my basket
+	//
+	//     1 scenario (0 undefined, 0 failures, 0 pending)
+	//     1 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+}
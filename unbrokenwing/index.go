@@ -0,0 +1,80 @@
+package unbrokenwing
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"sort"
+)
+
+// stepIndex narrows down step definitions possibly matching a step
+// description. Definitions are stored in a trie keyed by the literal
+// text their pattern must begin with, hence only definitions along
+// the path of the step description needs to be matched.
+type stepIndex struct {
+	root trieNode
+}
+
+type trieNode struct {
+	children    map[byte]*trieNode
+	definitions []*stepDefinition
+}
+
+func (index *stepIndex) add(definition *stepDefinition) {
+	node := &index.root
+	prefix := definition.expr.prefix()
+
+	for i := 0; i < len(prefix); i++ {
+		if node.children == nil {
+			node.children = map[byte]*trieNode{}
+		}
+
+		child, ok := node.children[prefix[i]]
+		if !ok {
+			child = &trieNode{}
+			node.children[prefix[i]] = child
+		}
+
+		node = child
+	}
+
+	node.definitions = append(node.definitions, definition)
+}
+
+// candidates returns definitions that might match text, in registration order.
+func (index *stepIndex) candidates(text string) []*stepDefinition {
+	node := &index.root
+	candidates := append([]*stepDefinition{}, node.definitions...)
+
+	for i := 0; i < len(text) && node.children != nil; i++ {
+		if node = node.children[text[i]]; node == nil {
+			break
+		}
+
+		candidates = append(candidates, node.definitions...)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].order < candidates[j].order })
+
+	return candidates
+}
+
+// literalPrefix returns literal text that must begin any text matched by r.
+// Expressions not anchored at beginning of text have no such prefix.
+func literalPrefix(r *regexp.Regexp) string {
+	re, err := syntax.Parse(r.String(), syntax.Perl)
+	if err != nil || re.Op != syntax.OpConcat || len(re.Sub) == 0 || re.Sub[0].Op != syntax.OpBeginText {
+		return ""
+	}
+
+	prefix := ""
+
+	for _, sub := range re.Sub[1:] {
+		if sub.Op != syntax.OpLiteral || sub.Flags&syntax.FoldCase != 0 {
+			break
+		}
+
+		prefix += string(sub.Rune)
+	}
+
+	return prefix
+}
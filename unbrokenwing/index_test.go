@@ -0,0 +1,43 @@
+package unbrokenwing
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func Example_literalPrefix() {
+	for _, pattern := range []string{
+		`^I have (\d+) cukes$`,
+		`^I (?:have|eat) cukes$`,
+		`I have cukes$`,
+		`^(?i)I have cukes$`,
+	} {
+		fmt.Printf("%q\n", literalPrefix(regexp.MustCompile(pattern)))
+	}
+
+	e, _ := newCucumberExpression("I have {int} cuke(s) in my belly")
+	fmt.Printf("%q\n", e.prefix())
+	// Output:
+	// "I have "
+	// "I "
+	// ""
+	// ""
+	// "I have "
+}
+
+func Example_stepIndex() {
+	index := stepIndex{}
+
+	for i, pattern := range []string{"I have {int} cukes", "^I eat (\\d+) cukes$", "^.*cukes$", "I have {int} apples"} {
+		expr, _ := newExpression(pattern)
+		index.add(&stepDefinition{pattern: pattern, order: i, expr: expr})
+	}
+
+	for _, definition := range index.candidates("I have 3 cukes") {
+		fmt.Println(definition.pattern)
+	}
+	// Output:
+	// I have {int} cukes
+	// ^.*cukes$
+	// I have {int} apples
+}
@@ -1,49 +1,158 @@
 package unbrokenwing
 
-import "regexp"
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
 
-var stepRegister = []func(string, bool) (match bool, err error){}
+// stepDefinition is a behaviour registered by one of
+// the functions Given, When, Then, But or And.
+type stepDefinition struct {
+	keyword  string // Keyword used to register definition, e.g. Given
+	pattern  string
+	location string // file:line where the definition was registered
+	order    int    // Position in stepRegister
+	expr     expression
+	call     func(stepArguments) error
+}
+
+func (definition *stepDefinition) String() string {
+	return fmt.Sprintf(`%s("%s") (%s)`, definition.keyword, definition.pattern, definition.location)
+}
+
+// run calls the step definition with arguments. Panics are recovered
+// and returned as failures, including a stack trace trimmed to frames
+// outside of unbrokenwing i.e., frames of the step definition.
+func (definition *stepDefinition) run(arguments stepArguments) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = Failure(fmt.Sprintf("panic: %v\n%s", r, panicTrace()))
+		}
+	}()
+
+	return definition.call(arguments)
+}
+
+// panicTrace returns frames between a recovered panic and the recovering function.
+func panicTrace() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs)])
+	trace := []string{}
+	panicking := false
+
+	for frame, more := frames.Next(); more; frame, more = frames.Next() {
+		if frame.Function == "runtime.gopanic" {
+			panicking = true
+			continue
+		} else if !panicking || strings.HasPrefix(frame.Function, "runtime.") || strings.HasPrefix(frame.Function, "reflect.") {
+			continue
+		} else if strings.HasPrefix(frame.Function, "gomate.io/gomate/unbrokenwing.") {
+			break // Reached driver calling the step definition
+		}
+
+		trace = append(trace, fmt.Sprintf("%s\n\t%s:%d", frame.Function, relative(frame.File), frame.Line))
+	}
+
+	return strings.Join(trace, "\n")
+}
+
+// accepts reports if definition may implement steps with keyword,
+// where And and But steps has been resolved to preceding keyword.
+// Definitions registered by And or But accepts all keywords, as
+// do all definitions for steps without preceding primary keyword.
+func (definition *stepDefinition) accepts(keyword string) bool {
+	return keyword == "" || keyword == definition.keyword || isConjunction(definition.keyword)
+}
+
+// isConjunction reports if keyword continues preceding step, i.e. And, But or *.
+func isConjunction(keyword string) bool {
+	return keyword == "And" || keyword == "But" || keyword == "*"
+}
+
+var stepRegister = []*stepDefinition{}
+var stepLookup = stepIndex{}
 
 // https://github.com/cucumber/cucumber/wiki/Given-When-Then
-func stepImplementation(step string, do func(Args) error) {
-	stepRegister = append(stepRegister, func(line string, optout bool) (match bool, err error) {
-		match = false
-		r, err := regexp.Compile(step)
-
-		if err == nil {
-			if r.MatchString(line) {
-				match = true
-				if !optout {
-					err = do(getArgs(r, line))
-				}
-			}
+func stepImplementation(keyword, step string, do interface{}) {
+	location := caller(2)
+
+	expr, err := newExpression(step)
+	if err != nil {
+		log.Fatalf("%s: invalid step definition: %s", location, err)
+	}
+
+	call, err := newStepFunc(do)
+	if err != nil {
+		log.Fatalf("%s: invalid step definition %q: %s", location, step, err)
+	}
+
+	definition := &stepDefinition{keyword, step, location, len(stepRegister), expr, call}
+	stepRegister = append(stepRegister, definition)
+	stepLookup.add(definition)
+}
+
+// caller returns file:line of the function skip frames up the stack,
+// relative to current working directory when possible.
+func caller(skip int) string {
+	_, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return "unknown"
+	}
+
+	return fmt.Sprintf("%s:%d", relative(file), line)
+}
+
+// relative returns file relative to current working directory, when located underneath it.
+func relative(file string) string {
+	if cwd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(cwd, file); err == nil && !strings.HasPrefix(rel, "..") {
+			return rel
 		}
+	}
 
-		return
-	})
+	return file
 }
 
 // Given are used to map scenario steps with behaviours,
-// this is mapped by matching regular expression in first
-// argument against scenario step in Gherkin language.
-func Given(step string, do func(Args) error) (err error) { stepImplementation(step, do); return }
+// this is mapped by matching regular expression or Cucumber
+// Expression in first argument against scenario step in Gherkin language.
+// Second argument is either a func(Args) error, or a function
+// returning error with one argument per parameter in first argument.
+// Invalid definitions terminates the program, reporting their location.
+func Given(step string, do interface{}) (err error) { stepImplementation("Given", step, do); return }
 
 // When are used to map scenario steps with behaviours,
-// this is mapped by matching regular expression in first
-// argument against scenario step in Gherkin language.
-func When(step string, do func(Args) error) (err error) { stepImplementation(step, do); return }
+// this is mapped by matching regular expression or Cucumber
+// Expression in first argument against scenario step in Gherkin language.
+// Second argument is either a func(Args) error, or a function
+// returning error with one argument per parameter in first argument.
+// Invalid definitions terminates the program, reporting their location.
+func When(step string, do interface{}) (err error) { stepImplementation("When", step, do); return }
 
 // Then are used to map scenario steps with behaviours,
-// this is mapped by matching regular expression in first
-// argument against scenario step in Gherkin language.
-func Then(step string, do func(Args) error) (err error) { stepImplementation(step, do); return }
+// this is mapped by matching regular expression or Cucumber
+// Expression in first argument against scenario step in Gherkin language.
+// Second argument is either a func(Args) error, or a function
+// returning error with one argument per parameter in first argument.
+// Invalid definitions terminates the program, reporting their location.
+func Then(step string, do interface{}) (err error) { stepImplementation("Then", step, do); return }
 
 // But are used to map scenario steps with behaviours,
-// this is mapped by matching regular expression in first
-// argument against scenario step in Gherkin language.
-func But(step string, do func(Args) error) (err error) { stepImplementation(step, do); return }
+// this is mapped by matching regular expression or Cucumber
+// Expression in first argument against scenario step in Gherkin language.
+// Second argument is either a func(Args) error, or a function
+// returning error with one argument per parameter in first argument.
+// Invalid definitions terminates the program, reporting their location.
+func But(step string, do interface{}) (err error) { stepImplementation("But", step, do); return }
 
 // And are used to map scenario steps with behaviours,
-// this is mapped by matching regular expression in first
-// argument against scenario step in Gherkin language.
-func And(step string, do func(Args) error) (err error) { stepImplementation(step, do); return }
+// this is mapped by matching regular expression or Cucumber
+// Expression in first argument against scenario step in Gherkin language.
+// Second argument is either a func(Args) error, or a function
+// returning error with one argument per parameter in first argument.
+// Invalid definitions terminates the program, reporting their location.
+func And(step string, do interface{}) (err error) { stepImplementation("And", step, do); return }
@@ -0,0 +1,68 @@
+package unbrokenwing
+
+import "sync"
+
+// SerialTag opts scenarios out of parallel execution, scenarios
+// tagged with it never run concurrently with other scenarios.
+// Tagging a feature applies the tag to all its scenarios.
+const SerialTag = "@serial"
+
+// schedule calls test for each scenario in feature. Settings.Parallel
+// scenarios are tested concurrently by a pool of workers, scenarios
+// tagged by SerialTag waits for running scenarios and runs alone.
+// Features are never tested concurrently, each scenario is isolated
+// from the others by its Context.
+func (ts *suite) schedule(feature Feature, test func(int, Scenario)) {
+	if ts.settings.Parallel <= 1 {
+		for i, scenario := range feature.Scenarios {
+			test(i, scenario)
+		}
+
+		return
+	}
+
+	var workers sync.WaitGroup
+	var exclusive sync.RWMutex // Held for writing by serial scenarios
+
+	queue := make(chan int)
+	serial := hasTag(feature.Tags, SerialTag)
+
+	for w := 0; w < ts.settings.Parallel; w++ {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+
+			for i := range queue {
+				scenario := feature.Scenarios[i]
+
+				if serial || hasTag(scenario.Tags, SerialTag) {
+					exclusive.Lock()
+					test(i, scenario)
+					exclusive.Unlock()
+				} else {
+					exclusive.RLock()
+					test(i, scenario)
+					exclusive.RUnlock()
+				}
+			}
+		}()
+	}
+
+	for i := range feature.Scenarios {
+		queue <- i
+	}
+
+	close(queue)
+	workers.Wait()
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,144 @@
+package unbrokenwing_test
+
+import (
+	"bytes"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dekelund/stdres"
+	. "gomate.io/gomate/unbrokenwing"
+)
+
+func ExampleSettings_parallel() {
+	stdres.DisableColor()
+
+	var running int32
+
+	Given("a kettle boiling for {int} ms", func(ms int) error {
+		atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		time.Sleep(time.Duration(ms) * time.Millisecond)
+		return nil
+	})
+	Given("the kitchen is empty", func(args Args) error {
+		if n := atomic.LoadInt32(&running); n != 0 {
+			return Failure(fmt.Sprintf("%d kettles boiling", n))
+		}
+
+		return nil
+	})
+
+	buffer := bytes.NewBufferString(`
+Feature: Boil water
+
+  Scenario: Slow kettle
+    Given a kettle boiling for 30 ms
+
+  Scenario: Fast kettle
+    Given a kettle boiling for 1 ms
+
+  @serial
+  Scenario: Clean kitchen
+    Given the kitchen is empty
+
+  Scenario: Another kettle
+    Given a kettle boiling for 10 ms
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{Parallel: 3})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Boil water
+	//
+	//   Scenario: Slow kettle
+	//
+	//     Given a kettle boiling for 30 ms
+	//
+	//   Scenario: Fast kettle
+	//
+	//     Given a kettle boiling for 1 ms
+	//
+	//   Scenario: Clean kitchen
+	//
+	//     Given the kitchen is empty
+	//
+	//   Scenario: Another kettle
+	//
+	//     Given a kettle boiling for 10 ms
+	//
+	//     4 scenario (0 undefined, 0 failures, 0 pending)
+	//     4 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+}
+
+func ExampleContext() {
+	stdres.DisableColor()
+
+	Given("a basket of {int} apples", func(ctx *Context, apples int) error {
+		ctx.Set("apples", apples)
+		return nil
+	})
+	When("I wait {int} ms", func(ms int) error {
+		time.Sleep(time.Duration(ms) * time.Millisecond)
+		return nil
+	})
+	Then("the basket holds {int} apples", func(ctx *Context, expected int) error {
+		if apples := ctx.Get("apples"); apples != expected {
+			return Failure(fmt.Sprintf("%s: expected %d apples, got %v", ctx.Scenario.Description, expected, apples))
+		}
+
+		return nil
+	})
+
+	buffer := bytes.NewBufferString(`
+Feature: Count apples
+
+  Scenario: Slow basket
+    Given a basket of 3 apples
+    When I wait 20 ms
+    Then the basket holds 3 apples
+
+  Scenario: Fast basket
+    Given a basket of 5 apples
+    When I wait 1 ms
+    Then the basket holds 5 apples
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{Parallel: 2})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Count apples
+	//
+	//   Scenario: Slow basket
+	//
+	//     Given a basket of 3 apples
+	//
+	//     When I wait 20 ms
+	//
+	//     Then the basket holds 3 apples
+	//
+	//   Scenario: Fast basket
+	//
+	//     Given a basket of 5 apples
+	//
+	//     When I wait 1 ms
+	//
+	//     Then the basket holds 5 apples
+	//
+	//     2 scenario (0 undefined, 0 failures, 0 pending)
+	//     6 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+}
@@ -0,0 +1,213 @@
+package unbrokenwing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// parameterType describes a {name} placeholder usable in Cucumber Expressions.
+// Transformer converts matching text before it's passed to typed step definitions.
+type parameterType struct {
+	name        string
+	regexp      string
+	transformer func(string) (interface{}, error)
+}
+
+var parameterTypes = map[string]*parameterType{
+	"int": {"int", `-?\d+`, func(s string) (interface{}, error) {
+		return strconv.Atoi(s)
+	}},
+	"float": {"float", `[-+]?(?:\d+(?:\.\d*)?|\.\d+)(?:[eE][-+]?\d+)?`, func(s string) (interface{}, error) {
+		return strconv.ParseFloat(s, 64)
+	}},
+	"word": {"word", `[^\s]+`, nil},
+	"string": {"string", `"([^"\\]*(?:\\.[^"\\]*)*)"|'([^'\\]*(?:\\.[^'\\]*)*)'`, func(s string) (interface{}, error) {
+		return strings.NewReplacer(`\"`, `"`, `\'`, `'`, `\\`, `\`).Replace(s), nil
+	}},
+	"": {"", `.*`, nil},
+}
+
+// ParameterType registers a custom parameter type, which makes {name} usable in
+// Cucumber Expressions. Steps matching the regular expression expr are converted
+// by transformer before they are passed to typed step definitions, errors
+// returned by transformer fail the step. A nil transformer passes the text as is.
+//
+// Parameter types must be registered before step definitions making use of them.
+// Invalid parameter types terminates the program, reporting their location.
+func ParameterType(name, expr string, transformer func(string) (interface{}, error)) (err error) {
+	location := caller(1)
+
+	if name == "" || strings.ContainsAny(name, "{}()/\\ \t") {
+		log.Fatalf("%s: illegal parameter type name: %q", location, name)
+	} else if _, ok := parameterTypes[name]; ok {
+		log.Fatalf("%s: parameter type {%s} already registered", location, name)
+	} else if _, err = regexp.Compile(expr); err != nil {
+		log.Fatalf("%s: parameter type {%s}: %s", location, name, err)
+	}
+
+	parameterTypes[name] = &parameterType{name, expr, transformer}
+
+	return nil
+}
+
+// ParameterTypes returns the regular expression of each
+// registered parameter type, keyed by parameter type name.
+func ParameterTypes() map[string]string {
+	types := map[string]string{}
+
+	for name, p := range parameterTypes {
+		types[name] = p.regexp
+	}
+
+	return types
+}
+
+// WriteParameterTypes writes ParameterTypes to the file at path as JSON, e.g. for
+// compiled step definitions to report custom parameter types they register.
+func WriteParameterTypes(path string) error {
+	data, err := json.Marshal(ParameterTypes())
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// transform converts a captured argument into a value assignable to typ.
+func (a argument) transform(typ reflect.Type) (reflect.Value, error) {
+	var value interface{} = a.value
+
+	if docString, ok := a.step.(DocString); ok && typ.Kind() == reflect.String {
+		value = docString.Content
+	} else if a.step != nil {
+		value = a.step
+	} else if a.parameter != nil && a.parameter.transformer != nil {
+		var err error
+
+		if value, err = a.parameter.transformer(a.value); err != nil {
+			return reflect.Value{}, fmt.Errorf("parameter type {%s}: %s", a.parameter.name, err)
+		}
+	}
+
+	if value == nil {
+		return reflect.Zero(typ), nil
+	}
+
+	v := reflect.ValueOf(value)
+
+	if v.Type().AssignableTo(typ) {
+		return v, nil
+	} else if s, ok := value.(string); ok {
+		return parseString(s, typ)
+	} else if v.Type().ConvertibleTo(typ) && !(isNumeric(v.Kind()) && typ.Kind() == reflect.String) {
+		return v.Convert(typ), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("cannot use %v (%T) as %s", value, value, typ)
+}
+
+func isNumeric(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Float64
+}
+
+// parseString converts text captured by regular expressions,
+// or untransformed parameters, to basic types.
+func parseString(s string, typ reflect.Type) (reflect.Value, error) {
+	v := reflect.New(typ).Elem()
+
+	switch kind := typ.Kind(); {
+	case kind == reflect.String:
+		v.SetString(s)
+	case kind >= reflect.Int && kind <= reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+
+		v.SetInt(i)
+	case kind >= reflect.Uint && kind <= reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+
+		v.SetUint(u)
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		f, err := strconv.ParseFloat(s, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+
+		v.SetFloat(f)
+	case kind == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return v, err
+		}
+
+		v.SetBool(b)
+	default:
+		return v, fmt.Errorf("cannot use %q as %s", s, typ)
+	}
+
+	return v, nil
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// newStepFunc wraps a step definition callback. Callbacks either take
+// Args, or one typed argument per parameter in the step pattern. Both
+// kinds may take the scenario *Context as first argument. In all cases
+// the callback must return an error.
+func newStepFunc(do interface{}) (func(stepArguments) error, error) {
+	switch do := do.(type) {
+	case func(Args) error:
+		return func(arguments stepArguments) error { return do(arguments.args) }, nil
+	case func(*Context, Args) error:
+		return func(arguments stepArguments) error { return do(arguments.ctx, arguments.args) }, nil
+	}
+
+	fn := reflect.ValueOf(do)
+
+	if do == nil || fn.Kind() != reflect.Func {
+		return nil, errors.New("step definition must be a function")
+	}
+
+	typ := fn.Type()
+	scoped := typ.NumIn() > 0 && typ.In(0) == contextType // Takes *Context first
+
+	if typ.NumOut() != 1 || typ.Out(0) != errorType || typ.IsVariadic() {
+		return nil, fmt.Errorf("step definition %s must return error", typ)
+	}
+
+	return func(arguments stepArguments) error {
+		in := []reflect.Value{}
+
+		if scoped {
+			in = append(in, reflect.ValueOf(arguments.ctx))
+		}
+
+		if len(in)+len(arguments.values) != typ.NumIn() {
+			return Failure(fmt.Sprintf("step definition %s expects %d arguments, step supplied %d", typ, typ.NumIn()-len(in), len(arguments.values)))
+		}
+
+		for i, a := range arguments.values {
+			v, err := a.transform(typ.In(len(in)))
+			if err != nil {
+				return Failure(fmt.Sprintf("argument %d: %s", i+1, err))
+			}
+
+			in = append(in, v)
+		}
+
+		err, _ := fn.Call(in)[0].Interface().(error)
+		return err
+	}, nil
+}
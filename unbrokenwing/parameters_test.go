@@ -0,0 +1,61 @@
+package unbrokenwing_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dekelund/stdres"
+	. "gomate.io/gomate/unbrokenwing"
+)
+
+type role string
+
+func ExampleParameterType() {
+	stdres.DisableColor()
+
+	ParameterType("role", "[a-z]+", func(s string) (interface{}, error) {
+		if s != "admin" && s != "developer" {
+			return nil, errors.New("unknown role " + s)
+		}
+
+		return role(strings.ToUpper(s)), nil
+	})
+
+	Given("a user with role {role} and {int} projects", func(r role, projects int) error {
+		fmt.Printf("%s has %d projects\n", r, projects)
+		return nil
+	})
+
+	buffer := bytes.NewBufferString(`
+Feature: Roles
+
+  Scenario: Known and unknown roles
+    Given a user with role admin and 3 projects
+    Given a user with role hacker and 5 projects
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuite()
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// ADMIN has 3 projects
+	// Feature: Roles
+	//
+	//   Scenario: Known and unknown roles
+	//
+	//     Given a user with role admin and 3 projects
+	//
+	//     Given a user with role hacker and 5 projects
+	//       argument 1: parameter type {role}: unknown role hacker
+	//
+	//     1 scenario (0 undefined, 1 failures, 0 pending)
+	//     2 steps (0 undefined, 1 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+}
@@ -0,0 +1,105 @@
+package unbrokenwing
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/dekelund/stdres"
+)
+
+// retryTagRegexp matches tags retrying failing scenarios e.g., @retry(3) tests
+// a failing scenario up to 3 more times. Tagging a feature applies the tag to
+// all its scenarios.
+var retryTagRegexp = regexp.MustCompile(`^@retry\((?P<retries>[0-9]+)\)$`)
+
+// retries returns how many times scenario shall be retried while failing,
+// scenario tags takes precedence over inherited feature tags and Settings.Retry.
+func (ts *suite) retries(scenario Scenario) int {
+	for i := len(scenario.Tags) - 1; i >= 0; i-- { // Inherited feature and rule tags comes first
+		if matches := retryTagRegexp.FindStringSubmatch(scenario.Tags[i]); matches != nil {
+			retries, _ := strconv.Atoi(matches[1]) // Digits only, according to regexp
+			return retries
+		}
+	}
+
+	return ts.settings.Retry
+}
+
+// Attempt is one test of a scenario configured to be retried, appended as a line
+// of JSON to the attempts file configured by Settings. Number counts attempts from
+// 1 up to Of, the maximum number of attempts. Result is one of "passed", "failed",
+// "ambiguous", "pending", "undefined" or "skipped", and Error the error failing
+// the attempt.
+type Attempt struct {
+	Location
+	Scenario string
+	Number   int
+	Of       int
+	Result   string
+	Error    string
+}
+
+// retryScenario tests scenario, and tests it again up to retries times while failing.
+// Every attempt is printed to out and recorded, see Attempt, but only counters of the
+// last attempt are added to the suite. Scenarios passing after failed attempts are
+// reported as flaky. Ambiguous scenarios are not retried, neither are scenarios of
+// dry runs, as their steps would match the same step definitions again.
+func (ts *suite) retryScenario(scenario Scenario, retries int, out *stdres.Buffer) error {
+	if retries <= 0 {
+		return ts.testScenario(scenario, out)
+	} else if ts.settings.DryRun {
+		retries = 0 // Attempted once, and recorded as such
+	}
+
+	for attempt := 1; ; attempt++ {
+		try := &suite{settings: ts.settings, missingImpl: map[string][]Location{}}
+		err := try.testScenario(scenario, out)
+
+		ts.addAttempt(Attempt{scenario.Location, scenario.Description, attempt, retries + 1, result(err), ""}, err)
+
+		if _, ambiguous := err.(AmbiguousError); isFailure(err) && !ambiguous && attempt <= retries {
+			out.Println(fmt.Sprintf("    Attempt %d of %d failed, retrying\n", attempt, retries+1)).Result = stdres.FAILURE
+			continue
+		}
+
+		ts.merge(try)
+
+		if err == nil && attempt > 1 {
+			ts.count(&ts.flakyScenarios)
+			out.Println(fmt.Sprintf("    Flaky, passed at attempt %d of %d\n", attempt, retries+1)).Result = stdres.PENDING
+		}
+
+		return err
+	}
+}
+
+// addAttempt records attempt, failed by err unless nil.
+func (ts *suite) addAttempt(attempt Attempt, err error) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if isFailure(err) {
+		attempt.Error = err.Error()
+	}
+
+	ts.attempts = append(ts.attempts, attempt)
+}
+
+// result names the result of a scenario tested by testScenario, see Attempt.
+func result(err error) string {
+	switch err.(type) {
+	case nil:
+		return "passed"
+	case PendingError:
+		return "pending"
+	case NotImplError:
+		return "undefined"
+	case AmbiguousError:
+		return "ambiguous"
+	case skippedError:
+		return "skipped"
+	}
+
+	return "failed"
+}
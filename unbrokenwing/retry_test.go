@@ -0,0 +1,283 @@
+package unbrokenwing_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dekelund/stdres"
+	. "gomate.io/gomate/unbrokenwing"
+)
+
+func ExampleSettings_retry() {
+	stdres.DisableColor()
+
+	requests := 0
+
+	When("I request the eventually consistent index", func(args Args) error {
+		requests++
+		return nil
+	})
+
+	Then("the document is indexed", func(args Args) error {
+		if requests < 2 {
+			return Failure("document not found")
+		}
+
+		return nil
+	})
+
+	buffer := bytes.NewBufferString(`
+Feature: Retry
+
+  @retry(2)
+  Scenario: Search indexed document
+    When I request the eventually consistent index
+    Then the document is indexed
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Retry
+	//
+	//   Scenario: Search indexed document
+	//
+	//     When I request the eventually consistent index
+	//
+	//     Then the document is indexed
+	//       document not found
+	//
+	//     Attempt 1 of 3 failed, retrying
+	//
+	//   Scenario: Search indexed document
+	//
+	//     When I request the eventually consistent index
+	//
+	//     Then the document is indexed
+	//
+	//     Flaky, passed at attempt 2 of 3
+	//
+	//     1 scenario (0 undefined, 0 failures, 0 pending, 1 flaky)
+	//     2 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+}
+
+func ExampleAttempt() {
+	stdres.DisableColor()
+
+	payments := 0
+
+	When("I pay with an unreliable card", func(args Args) error {
+		if payments++; payments < 3 {
+			return Failure(fmt.Sprintf("declined %d", payments))
+		}
+
+		return nil
+	})
+
+	buffer := bytes.NewBufferString(`
+Feature: Attempts
+
+  @retry(3)
+  Scenario: Pay
+    When I pay with an unreliable card
+`)
+
+	attempts, err := ioutil.TempFile("", "attempts")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.Remove(attempts.Name())
+	attempts.Close()
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{Feature: "features/pay.feature", Attempts: attempts.Name()})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	file, _ := os.Open(attempts.Name())
+	defer file.Close()
+
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		attempt := Attempt{}
+
+		if err := json.Unmarshal(scanner.Bytes(), &attempt); err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("%s %s: %d of %d %s %q\n", attempt.Location, attempt.Scenario, attempt.Number, attempt.Of, attempt.Result, attempt.Error)
+	}
+
+	// Output:
+	// Feature: Attempts
+	//
+	//   Scenario: Pay
+	//
+	//     When I pay with an unreliable card
+	//       declined 1
+	//       at features/pay.feature:6
+	//
+	//     Attempt 1 of 4 failed, retrying
+	//
+	//   Scenario: Pay
+	//
+	//     When I pay with an unreliable card
+	//       declined 2
+	//       at features/pay.feature:6
+	//
+	//     Attempt 2 of 4 failed, retrying
+	//
+	//   Scenario: Pay
+	//
+	//     When I pay with an unreliable card
+	//
+	//     Flaky, passed at attempt 3 of 4
+	//
+	//     1 scenario (0 undefined, 0 failures, 0 pending, 1 flaky)
+	//     1 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	// features/pay.feature:5 Pay: 1 of 4 failed "declined 1"
+	// features/pay.feature:5 Pay: 2 of 4 failed "declined 2"
+	// features/pay.feature:5 Pay: 3 of 4 passed ""
+}
+
+func ExampleAttempt_dryRun() {
+	stdres.DisableColor()
+
+	When("I pay in cash", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Dry attempts
+
+  @retry(3)
+  Scenario: Pay with card
+    When I pay with a card
+
+  @retry(3)
+  Scenario: Pay in cash
+    When I pay in cash
+`)
+
+	attempts, err := ioutil.TempFile("", "attempts")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.Remove(attempts.Name())
+	attempts.Close()
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{Feature: "features/pay.feature", DryRun: true, Attempts: attempts.Name()})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	file, _ := os.Open(attempts.Name())
+	defer file.Close()
+
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		attempt := Attempt{}
+
+		if err := json.Unmarshal(scanner.Bytes(), &attempt); err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("%s %s: %d of %d %s\n", attempt.Location, attempt.Scenario, attempt.Number, attempt.Of, attempt.Result)
+	}
+
+	// Output:
+	// Feature: Dry attempts
+	//
+	//   Scenario: Pay with card
+	//
+	//     When I pay with a card
+	//
+	//   Scenario: Pay in cash
+	//
+	//     When I pay in cash
+	//
+	//     2 scenario (1 undefined, 0 failures, 0 pending, 1 skipped)
+	//     2 steps (1 undefined, 0 failures, 0 pending, 0 optout, 1 skipped)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	//     // Undefined at features/pay.feature:6
+	//     When("^I pay with a card$", func(args Args) error {
+	//         return Pending("Not implemented")
+	//     })
+	// features/pay.feature:5 Pay with card: 1 of 1 undefined
+	// features/pay.feature:9 Pay in cash: 1 of 1 skipped
+}
+
+func ExampleAttempt_ambiguous() {
+	stdres.DisableColor()
+
+	When("I pay {int} SEK", func(amount int) error { return nil })
+	When("I pay {int} {word}", func(amount int, currency string) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Ambiguous attempts
+
+  @retry(3)
+  Scenario: Pay in SEK
+    When I pay 10 SEK
+`)
+
+	attempts, err := ioutil.TempFile("", "attempts")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.Remove(attempts.Name())
+	attempts.Close()
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{Feature: "features/pay.feature", Attempts: attempts.Name()})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	file, _ := os.Open(attempts.Name())
+	defer file.Close()
+
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		attempt := Attempt{}
+
+		if err := json.Unmarshal(scanner.Bytes(), &attempt); err != nil {
+			panic(err)
+		}
+
+		fmt.Printf("%s %s: %d of %d %s\n", attempt.Location, attempt.Scenario, attempt.Number, attempt.Of, attempt.Result)
+	}
+
+	// Output:
+	// Feature: Ambiguous attempts
+	//
+	//   Scenario: Pay in SEK
+	//
+	//     When I pay 10 SEK
+	//       Ambiguous step, matching step definitions:
+	//         When("I pay {int} SEK") (retry_test.go:226)
+	//         When("I pay {int} {word}") (retry_test.go:227)
+	//       at features/pay.feature:6
+	//
+	//     1 scenario (0 undefined, 0 failures, 0 pending, 1 ambiguous)
+	//     1 steps (0 undefined, 0 failures, 0 pending, 0 optout, 1 ambiguous)
+	//
+	//     Failing scenarios:
+	//       features/pay.feature:5 # Scenario: Pay in SEK
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	// features/pay.feature:5 Pay in SEK: 1 of 4 ambiguous
+}
@@ -0,0 +1,147 @@
+package unbrokenwing
+
+import (
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExitFailure is the exit code of generated definitions
+// binaries, when at least one scenario failed.
+const ExitFailure = 3
+
+// Settings configures how a Suite tests features. Settings are
+// supplied to generated definitions binaries as command line flags.
+type Settings struct {
+	Pretty         bool   // Print colorised result to STDOUT
+	StrictKeywords bool   // Then steps only matches Then definitions etc.
+	Parallel       int    // Number of scenarios within a feature tested concurrently, see Context
+	FailFast       bool   // Skip remaining scenarios after first failure
+	Random         bool   // Test scenarios in random order, instead of defined order
+	Seed           int64  // Seed used to randomise order, reproduces order of earlier runs
+	Feature        string // Path of tested feature file, as presented to users
+	Lines          []int  // Only test scenarios containing one of the lines, all if empty
+	Rerun          string // Path of file where failed and undefined scenarios are appended, as Feature:LINE
+	Name           string // Only test scenarios with names matching regular expression, all if empty
+	DryRun         bool   // Match steps against step definitions without calling them
+	Retry          int    // Number of times failing scenarios are tested again, unless tagged @retry(N)
+	Snippets       string // Path of file where undefined steps are appended, as lines of JSON
+	SnippetStyle   string // Style of snippets for undefined steps, RegexpSnippets by default
+	Attempts       string // Path of file where attempts of retried scenarios are appended, as lines of JSON
+	ParameterTypes string // Path of file where registered parameter types are written as JSON, instead of testing
+}
+
+// Flags defines command line flags on flags, that configures settings when parsed.
+func (settings *Settings) Flags(flags *flag.FlagSet) {
+	flags.BoolVar(&settings.Pretty, "pretty", settings.Pretty, "Print colorised result to STDOUT")
+	flags.BoolVar(&settings.StrictKeywords, "strict-keywords", settings.StrictKeywords, "Only match step definitions registered with the steps keyword")
+	flags.IntVar(&settings.Parallel, "parallel", settings.Parallel, "Number of scenarios within a feature tested concurrently")
+	flags.BoolVar(&settings.FailFast, "fail-fast", settings.FailFast, "Skip remaining scenarios after first failure")
+	flags.BoolVar(&settings.Random, "random", settings.Random, "Test scenarios in random order")
+	flags.Int64Var(&settings.Seed, "seed", settings.Seed, "Seed used to randomise order of scenarios")
+	flags.StringVar(&settings.Feature, "feature", settings.Feature, "Path of tested feature file")
+	flags.Var((*lineList)(&settings.Lines), "lines", "Comma separated lines of scenarios to test")
+	flags.StringVar(&settings.Rerun, "rerun", settings.Rerun, "Append failed scenarios to file")
+	flags.StringVar(&settings.Name, "name", settings.Name, "Only test scenarios with names matching regular expression")
+	flags.BoolVar(&settings.DryRun, "dry-run", settings.DryRun, "Match steps without calling step definitions")
+	flags.IntVar(&settings.Retry, "retry", settings.Retry, "Number of times failing scenarios are tested again")
+	flags.StringVar(&settings.Snippets, "snippets", settings.Snippets, "Append undefined steps to file")
+	flags.StringVar(&settings.SnippetStyle, "snippet-style", settings.SnippetStyle, "Style of snippets: regexp or cucumber")
+	flags.StringVar(&settings.Attempts, "attempts", settings.Attempts, "Append attempts of retried scenarios to file")
+	flags.StringVar(&settings.ParameterTypes, "parameter-types", settings.ParameterTypes, "Write registered parameter types to file, instead of testing")
+}
+
+// Args returns command line arguments, which configures settings when parsed
+// by a flag set configured by Flags.
+func (settings Settings) Args() []string {
+	return []string{
+		"-pretty=" + strconv.FormatBool(settings.Pretty),
+		"-strict-keywords=" + strconv.FormatBool(settings.StrictKeywords),
+		"-parallel=" + strconv.Itoa(settings.Parallel),
+		"-fail-fast=" + strconv.FormatBool(settings.FailFast),
+		"-random=" + strconv.FormatBool(settings.Random),
+		"-seed=" + strconv.FormatInt(settings.Seed, 10),
+		"-feature=" + settings.Feature,
+		"-lines=" + lineList(settings.Lines).String(),
+		"-rerun=" + settings.Rerun,
+		"-name=" + settings.Name,
+		"-dry-run=" + strconv.FormatBool(settings.DryRun),
+		"-retry=" + strconv.Itoa(settings.Retry),
+		"-snippets=" + settings.Snippets,
+		"-snippet-style=" + settings.SnippetStyle,
+		"-attempts=" + settings.Attempts,
+		"-parameter-types=" + settings.ParameterTypes,
+	}
+}
+
+// lineList implements flag.Value for comma separated line numbers.
+type lineList []int
+
+func (lines lineList) String() string {
+	text := []string{}
+
+	for _, line := range lines {
+		text = append(text, strconv.Itoa(line))
+	}
+
+	return strings.Join(text, ",")
+}
+
+func (lines *lineList) Set(text string) error {
+	*lines = nil
+
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' }) {
+		line, err := strconv.Atoi(field)
+		if err != nil {
+			return fmt.Errorf("invalid line %q", field)
+		}
+
+		*lines = append(*lines, line)
+	}
+
+	return nil
+}
+
+// SetOrder configures order of scenarios from text "defined", "random"
+// or "random:SEED". Random order without seed generates a new seed.
+func (settings *Settings) SetOrder(order string) error {
+	switch {
+	case order == "defined":
+		settings.Random = false
+	case order == "random":
+		settings.Random = true
+		settings.Seed = time.Now().UnixNano()
+	case strings.HasPrefix(order, "random:"):
+		seed, err := strconv.ParseInt(strings.TrimPrefix(order, "random:"), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid seed in order %q: %s", order, err)
+		}
+
+		settings.Random = true
+		settings.Seed = seed
+	default:
+		return fmt.Errorf("invalid order %q, expected defined, random or random:SEED", order)
+	}
+
+	return nil
+}
+
+// Shuffler returns a function randomising order of n elements by calling swap,
+// as rand.Shuffle, when random order is configured by settings. All calls of the
+// returned function draws from one source, seeded by Seed and stream, so that each
+// shuffle of a run differs, e.g. scenarios of features with as many scenarios.
+// Streams, e.g. "features", selects independent sequences of the same seed.
+func (settings Settings) Shuffler(stream string) func(n int, swap func(i, j int)) {
+	if !settings.Random {
+		return func(int, func(i, j int)) {}
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(stream)) // Writes to hashes never fails
+
+	return rand.New(rand.NewSource(settings.Seed ^ int64(hash.Sum64()))).Shuffle // #nosec
+}
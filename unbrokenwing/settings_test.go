@@ -0,0 +1,412 @@
+package unbrokenwing_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/dekelund/stdres"
+	. "gomate.io/gomate/unbrokenwing"
+)
+
+func ExampleNewSuiteWithSettings_strictKeywords() {
+	stdres.DisableColor()
+
+	Given("a cart with {int} oranges", func(args Args) error { return nil })
+	When("I pay for the oranges", func(args Args) error { return nil })
+	Then("the receipt lists {int} oranges", func(args Args) error { return nil })
+	And("nothing else is bought", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Strict keywords
+
+  Scenario: Buy oranges
+    Given a cart with 2 oranges
+    When I pay for the oranges
+    Then the receipt lists 2 oranges
+    And I pay for the oranges
+    But nothing else is bought
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{StrictKeywords: true})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Strict keywords
+	//
+	//   Scenario: Buy oranges
+	//
+	//     Given a cart with 2 oranges
+	//
+	//     When I pay for the oranges
+	//
+	//     Then the receipt lists 2 oranges
+	//
+	//     And I pay for the oranges
+	//       Undefined Then step, matching step definitions with other keywords:
+	//         When("I pay for the oranges") (settings_test.go:19)
+	//
+	//     But nothing else is bought
+	//
+	//     1 scenario (1 undefined, 0 failures, 0 pending)
+	//     5 steps (1 undefined, 0 failures, 0 pending, 1 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	//     Then("^I pay for the oranges$", func(args Args) error {
+	//         return Pending("Not implemented")
+	//     })
+}
+
+func ExampleSettings_failFast() {
+	stdres.DisableColor()
+
+	Given("the database is down", func(args Args) error { return Failure("connection refused") })
+	Then("the database answers", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Fail fast
+
+  Scenario: Broken environment
+    Given the database is down
+
+  Scenario: Never tested
+    Then the database answers
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{FailFast: true})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	fmt.Println("failed:", t.Failed())
+
+	// Output:
+	// Feature: Fail fast
+	//
+	//   Scenario: Broken environment
+	//
+	//     Given the database is down
+	//       connection refused
+	//
+	//   Scenario: Never tested (skipped)
+	//
+	//     2 scenario (0 undefined, 1 failures, 0 pending, 1 skipped)
+	//     2 steps (0 undefined, 1 failures, 0 pending, 0 optout, 1 skipped)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	// failed: true
+}
+
+func ExampleSettings_SetOrder() {
+	stdres.DisableColor()
+
+	Given("shuffled scenario {int}", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Random order
+
+  Scenario: First
+    Given shuffled scenario 1
+
+  Scenario: Second
+    Given shuffled scenario 2
+
+  Scenario: Third
+    Given shuffled scenario 3
+
+Feature: Another random order
+
+  Scenario: Fourth
+    Given shuffled scenario 4
+
+  Scenario: Fifth
+    Given shuffled scenario 5
+
+  Scenario: Sixth
+    Given shuffled scenario 6
+`)
+
+	settings := Settings{}
+	if err := settings.SetOrder("random:1"); err != nil {
+		fmt.Println(err)
+	}
+
+	features, _ := ParseFeatures("", buffer)
+	suite := NewSuiteWithSettings(settings)
+	t := testing.T{}
+
+	for _, feature := range features {
+		suite.Test(*feature, &t) // Features of equal size are shuffled differently
+	}
+
+	// Output:
+	// Feature: Random order
+	//
+	//   Scenario: Third
+	//
+	//     Given shuffled scenario 3
+	//
+	//   Scenario: Second
+	//
+	//     Given shuffled scenario 2
+	//
+	//   Scenario: First
+	//
+	//     Given shuffled scenario 1
+	//
+	//     3 scenario (0 undefined, 0 failures, 0 pending)
+	//     3 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//     Randomized with seed 1
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	// Feature: Another random order
+	//
+	//   Scenario: Fourth
+	//
+	//     Given shuffled scenario 4
+	//
+	//   Scenario: Sixth
+	//
+	//     Given shuffled scenario 6
+	//
+	//   Scenario: Fifth
+	//
+	//     Given shuffled scenario 5
+	//
+	//     6 scenario (0 undefined, 0 failures, 0 pending)
+	//     6 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//     Randomized with seed 1
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+}
+
+func ExampleSettings_rerun() {
+	stdres.DisableColor()
+
+	Given("a flaky network", func(args Args) error { return Failure("timeout") })
+	Then("the request succeeds", func(args Args) error { return nil })
+	Given("a {word} request", func(args Args) error { return nil })
+	Given("a redirected {word}", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Rerun
+
+  Scenario: Not selected
+    Then the request succeeds
+
+  Scenario: Unreliable request
+    Given a flaky network
+    Then the request succeeds
+
+  Scenario: Unfinished request
+    Given a request without step definition
+    Then the request succeeds
+
+  Scenario: Confusing request
+    Given a redirected request
+    Then the request succeeds
+`)
+
+	rerun, err := ioutil.TempFile("", "rerun")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.Remove(rerun.Name())
+	rerun.Close()
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{Feature: "features/rerun.feature", Lines: []int{8, 12, 16}, Rerun: rerun.Name()})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	content, _ := ioutil.ReadFile(rerun.Name())
+	fmt.Print(string(content))
+
+	// Output:
+	// Feature: Rerun
+	//
+	//   Scenario: Unreliable request
+	//
+	//     Given a flaky network
+	//       timeout
+	//       at features/rerun.feature:8
+	//
+	//     Then the request succeeds
+	//
+	//   Scenario: Unfinished request
+	//
+	//     Given a request without step definition
+	//
+	//     Then the request succeeds
+	//
+	//   Scenario: Confusing request
+	//
+	//     Given a redirected request
+	//       Ambiguous step, matching step definitions:
+	//         Given("a {word} request") (settings_test.go:196)
+	//         Given("a redirected {word}") (settings_test.go:197)
+	//       at features/rerun.feature:16
+	//
+	//     Then the request succeeds
+	//
+	//     3 scenario (1 undefined, 1 failures, 0 pending, 1 ambiguous)
+	//     6 steps (1 undefined, 1 failures, 0 pending, 3 optout, 1 ambiguous)
+	//
+	//     Failing scenarios:
+	//       features/rerun.feature:7 # Scenario: Unreliable request
+	//       features/rerun.feature:15 # Scenario: Confusing request
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	//     // Undefined at features/rerun.feature:12
+	//     Given("^a request without step definition$", func(args Args) error {
+	//         return Pending("Not implemented")
+	//     })
+	// features/rerun.feature:7
+	// features/rerun.feature:11
+	// features/rerun.feature:15
+}
+
+func ExampleSettings_name() {
+	stdres.DisableColor()
+
+	Given("a logged in user", func(args Args) error { return nil })
+
+	buffer := bytes.NewBufferString(`
+Feature: Name filter
+
+  Scenario: Checkout with card
+    Given a logged in user
+
+  Scenario: Checkout with invoice
+    Given a logged in user
+
+  Scenario: Browse products
+    Given a logged in user
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{Name: "^Checkout"})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Name filter
+	//
+	//   Scenario: Checkout with card
+	//
+	//     Given a logged in user
+	//
+	//   Scenario: Checkout with invoice
+	//
+	//     Given a logged in user
+	//
+	//     2 scenario (0 undefined, 0 failures, 0 pending)
+	//     2 steps (0 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+}
+
+func ExampleSettings_dryRun() {
+	stdres.DisableColor()
+
+	Given("an empty database", func(args Args) error { panic("never called in dry runs") })
+
+	buffer := bytes.NewBufferString(`
+Feature: Dry run
+
+  Scenario: Register account
+    Given an empty database
+    When I register an account
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{DryRun: true})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	fmt.Println("Failed:", t.Failed())
+
+	// Output:
+	// Feature: Dry run
+	//
+	//   Scenario: Register account
+	//
+	//     Given an empty database
+	//
+	//     When I register an account
+	//
+	//     1 scenario (1 undefined, 0 failures, 0 pending)
+	//     2 steps (1 undefined, 0 failures, 0 pending, 0 optout, 1 skipped)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	//     When("^I register an account$", func(args Args) error {
+	//         return Pending("Not implemented")
+	//     })
+	// Failed: true
+}
+
+func ExampleSettings_snippets() {
+	stdres.DisableColor()
+
+	buffer := bytes.NewBufferString(`
+Feature: Snippets
+
+  Scenario: Order coffee
+    Given a menu listing "espresso" for 3 euros
+`)
+
+	snippets, err := ioutil.TempFile("", "snippets")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.Remove(snippets.Name())
+	snippets.Close()
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{Feature: "features/coffee.feature", DryRun: true, Snippets: snippets.Name()})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	content, _ := ioutil.ReadFile(snippets.Name())
+	step := UndefinedStep{}
+
+	if err := json.Unmarshal(content, &step); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(step.Location)
+	fmt.Println(step.Definition)
+
+	// Output:
+	// Feature: Snippets
+	//
+	//   Scenario: Order coffee
+	//
+	//     Given a menu listing "espresso" for 3 euros
+	//
+	//     1 scenario (1 undefined, 0 failures, 0 pending)
+	//     1 steps (1 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	//     // Undefined at features/coffee.feature:5
+	//     Given("^a menu listing \"(?P<arg1>[^\"]*)\" for (?P<arg2>-?[0-9]+) euros$", func(arg1 string, arg2 int) error {
+	//         return Pending("Not implemented")
+	//     })
+	// features/coffee.feature:5
+	// Given("^a menu listing \"(?P<arg1>[^\"]*)\" for (?P<arg2>-?[0-9]+) euros$", func(arg1 string, arg2 int) error {
+	// 	return Pending("Not implemented")
+	// })
+}
@@ -0,0 +1,86 @@
+package unbrokenwing
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Styles of snippets generated for undefined steps, see Settings.
+const (
+	RegexpSnippets   = "regexp"   // Regular expressions with named capture groups
+	CucumberSnippets = "cucumber" // Cucumber Expressions with parameter types
+)
+
+// parameterRegexp matches parts of step descriptions, captured as parameters
+// by generated step definitions. Words are identifiers mixing letters and
+// digits e.g., "A113", other words are kept as text.
+var parameterRegexp = regexp.MustCompile(`"(?P<string>[^"]*)"` +
+	`|(?P<word>\b(?:[A-Za-z]+[0-9]|[0-9]+[A-Za-z])[A-Za-z0-9]*\b)` +
+	`|(?P<float>-?\b[0-9]+\.[0-9]+\b)` +
+	`|(?P<int>-?\b[0-9]+\b)`)
+
+// snippetParameters describes parameters captured by generated step definitions,
+// keyed by group names of parameterRegexp. Regexp is a format of the capture
+// group, given the name of the parameter.
+var snippetParameters = map[string]struct {
+	regexp     string
+	expression string
+	typ        string
+}{
+	"string": {`"(?P<%s>[^"]*)"`, "{string}", "string"},
+	"word":   {`(?P<%s>[A-Za-z0-9]+)`, "{word}", "string"},
+	"float":  {`(?P<%s>-?[0-9]+\.[0-9]+)`, "{float}", "float64"},
+	"int":    {`(?P<%s>-?[0-9]+)`, "{int}", "int"},
+}
+
+// expressionEscaper escapes text with special meaning in Cucumber Expressions.
+var expressionEscaper = strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`, "{", `\{`, "}", `\}`, "/", `\/`)
+
+// definition generates a step definition matching the undefined step, returned as
+// pattern and Go code. Style selects if pattern is a regular expression or a Cucumber
+// Expression, regular expression by default. Quoted strings, words, floats and integers
+// are captured, and passed to the step definition as string, float64 and int
+// parameters, followed by the doc string or data table of the step, if any.
+func (e NotImplError) definition(style string) (pattern, code string) {
+	t := e.t
+	params := []string{}
+	expression, last := "", 0
+
+	for _, match := range parameterRegexp.FindAllStringSubmatchIndex(t.Description, -1) {
+		text := t.Description[last:match[0]]
+		name := fmt.Sprintf("arg%d", len(params)+1)
+		last = match[1]
+
+		for i, kind := range parameterRegexp.SubexpNames() {
+			if p, ok := snippetParameters[kind]; ok && match[2*i] >= 0 {
+				pattern += regexp.QuoteMeta(text) + fmt.Sprintf(p.regexp, name)
+				expression += expressionEscaper.Replace(text) + p.expression
+				params = append(params, name+" "+p.typ)
+			}
+		}
+	}
+
+	pattern = "^" + pattern + regexp.QuoteMeta(t.Description[last:]) + "$"
+	expression += expressionEscaper.Replace(t.Description[last:])
+
+	// Step text might look like a regular expression, e.g. "[" or "|"
+	if style == CucumberSnippets && !isRegexp(expression) {
+		pattern = expression
+	}
+
+	if t.DocString != nil {
+		params = append(params, "docString string")
+	} else if t.DataTable != nil {
+		params = append(params, "table DataTable")
+	}
+
+	if len(params) == 0 {
+		params = []string{"args Args"}
+	}
+
+	code = fmt.Sprintf("%s(%s, func(%s) error {\n\treturn Pending(\"Not implemented\")\n})", t.Cmd, strconv.Quote(pattern), strings.Join(params, ", "))
+
+	return pattern, code
+}
@@ -0,0 +1,136 @@
+package unbrokenwing_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dekelund/stdres"
+	. "gomate.io/gomate/unbrokenwing"
+)
+
+func ExampleSettings_snippetStyle() {
+	stdres.DisableColor()
+
+	text := `
+Feature: Groceries
+
+  Scenario: Order by weight
+    When I order 2.5 kg of item A113 (in stock) from "Deli/Bakery"
+    Then the cart contains:
+      | item | weight |
+      | A113 | 2.5    |
+`
+
+	for _, style := range []string{RegexpSnippets, CucumberSnippets} {
+		feature := NewFeature(bytes.NewBufferString(text))
+		suite := NewSuiteWithSettings(Settings{DryRun: true, SnippetStyle: style})
+		t := testing.T{}
+		suite.Test(*feature, &t)
+	}
+
+	// Output:
+	// Feature: Groceries
+	//
+	//   Scenario: Order by weight
+	//
+	//     When I order 2.5 kg of item A113 (in stock) from "Deli/Bakery"
+	//
+	//     Then the cart contains:
+	//       | item | weight |
+	//       | A113 | 2.5 |
+	//
+	//     1 scenario (1 undefined, 0 failures, 0 pending)
+	//     2 steps (2 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	//     Then("^the cart contains:$", func(table DataTable) error {
+	//         return Pending("Not implemented")
+	//     })
+	//
+	//     When("^I order (?P<arg1>-?[0-9]+\\.[0-9]+) kg of item (?P<arg2>[A-Za-z0-9]+) \\(in stock\\) from \"(?P<arg3>[^\"]*)\"$", func(arg1 float64, arg2 string, arg3 string) error {
+	//         return Pending("Not implemented")
+	//     })
+	// Feature: Groceries
+	//
+	//   Scenario: Order by weight
+	//
+	//     When I order 2.5 kg of item A113 (in stock) from "Deli/Bakery"
+	//
+	//     Then the cart contains:
+	//       | item | weight |
+	//       | A113 | 2.5 |
+	//
+	//     1 scenario (1 undefined, 0 failures, 0 pending)
+	//     2 steps (2 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	//     Then("the cart contains:", func(table DataTable) error {
+	//         return Pending("Not implemented")
+	//     })
+	//
+	//     When("I order {float} kg of item {word} \\(in stock\\) from {string}", func(arg1 float64, arg2 string, arg3 string) error {
+	//         return Pending("Not implemented")
+	//     })
+}
+
+func ExampleSettings_snippetConjunctions() {
+	stdres.DisableColor()
+
+	buffer := bytes.NewBufferString(`
+Feature: Checkout
+
+  Scenario: Pay by card
+    Given a cart with 3 items
+    And a saved card
+    When I check out
+    Then the order is paid
+    But no receipt is printed
+`)
+
+	feature := NewFeature(buffer)
+	suite := NewSuiteWithSettings(Settings{DryRun: true})
+	t := testing.T{}
+	suite.Test(*feature, &t)
+
+	// Output:
+	// Feature: Checkout
+	//
+	//   Scenario: Pay by card
+	//
+	//     Given a cart with 3 items
+	//
+	//     And a saved card
+	//
+	//     When I check out
+	//
+	//     Then the order is paid
+	//
+	//     But no receipt is printed
+	//
+	//     1 scenario (1 undefined, 0 failures, 0 pending)
+	//     5 steps (5 undefined, 0 failures, 0 pending, 0 optout)
+	//
+	//     You can implement step definition for undefined steps with these snippets:
+	//
+	//     Given("^a cart with (?P<arg1>-?[0-9]+) items$", func(arg1 int) error {
+	//         return Pending("Not implemented")
+	//     })
+	//
+	//     Given("^a saved card$", func(args Args) error {
+	//         return Pending("Not implemented")
+	//     })
+	//
+	//     Then("^no receipt is printed$", func(args Args) error {
+	//         return Pending("Not implemented")
+	//     })
+	//
+	//     Then("^the order is paid$", func(args Args) error {
+	//         return Pending("Not implemented")
+	//     })
+	//
+	//     When("^I check out$", func(args Args) error {
+	//         return Pending("Not implemented")
+	//     })
+}
@@ -4,33 +4,102 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 )
 
 // Args provides data structure for arguments
-// supplied to the step definition.
+// supplied to the step definition. Regular expressions
+// supply named groups keyed by name, Cucumber Expressions
+// supply parameters keyed by position starting at "1".
 type Args map[string]string
 
+// Location is the position of a parsed node in a feature file. File is
+// empty when the path of the feature file is unknown, Line and Column
+// starts at 1.
+type Location struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// String returns location as "path/file.feature:LINE", or "line LINE"
+// when the path is unknown.
+func (location Location) String() string {
+	if location.File == "" {
+		return fmt.Sprintf("line %d", location.Line)
+	}
+
+	return fmt.Sprintf("%s:%d", location.File, location.Line)
+}
+
 // Step corresponds to the a function related to
 // a Given, When and Then-step.
 //
-// Cmd correspons to one of following commands: Given, When, Then, But, And
+// Cmd correspons to one of following commands: Given, When, Then, But, And, *
+// Keyword is the command as written in the dialect of the feature file, e.g. Givet.
 // Description contains the rest of the text that follows after the command.
+// Location is the position of the step in the feature file.
+// DocString and DataTable holds the argument following the step, if any.
 type Step struct {
+	Location
 	Cmd         string
+	Keyword     string
 	Description string
+	DocString   *DocString
+	DataTable   DataTable
+}
+
+// DocString is a multi-line text argument delimited by """ or ```,
+// MediaType is the optional content type following the delimiter.
+// Step definitions receive Content when declaring a string parameter
+// after the captured arguments, or DocString as is.
+type DocString struct {
+	MediaType string
+	Content   string
 }
 
+// DataTable is a table argument, rows of cells with the header row first.
+// Step definitions receive it by declaring a DataTable parameter after
+// the captured arguments.
+type DataTable [][]string
+
 // String returns the original text before broken down to cmd and description.
 func (step Step) String() string {
-	return fmt.Sprintf("%s %s", step.Cmd, step.Description)
+	keyword := step.Keyword
+
+	if keyword == "" {
+		keyword = step.Cmd
+	}
+
+	if strings.HasSuffix(keyword, "'") {
+		return keyword + step.Description // Keywords such as "Lorsqu'" are not followed by space
+	}
+
+	return fmt.Sprintf("%s %s", keyword, step.Description)
 }
 
 // Scenario contains data structure matching scenarios in Gherkin.
-// Description holds all text from scenario line till first scenario step.
+// Description holds the name following the scenario keyword, and Text
+// the description lines following the scenario line.
+// Tags holds tags of the scenario, including tags inherited from feature,
+// rule and examples. Location is the position of the scenario line in the
+// feature file, or of the examples row for scenario outlines.
+// Steps starts with steps of feature and rule backgrounds.
 type Scenario struct {
+	Location
 	Description string
+	Text        string
+	Tags        []string
 	Steps       []Step
+	block       [2]int // Lines from scenario keyword till last scenario step
+}
+
+// contains reports if line is within scenario, i.e. the scenario line, one of
+// its steps or, for scenario outlines, the examples row the scenario was
+// compiled from.
+func (scenario Scenario) contains(line int) bool {
+	return line == scenario.Line || scenario.block[0] <= line && line <= scenario.block[1]
 }
 
 func (scenario Scenario) String() string {
@@ -39,10 +108,14 @@ func (scenario Scenario) String() string {
 
 // Feature contains data structure matching features in Gherkin.
 // Each Scenario in Scenarios contains Description and scenario
-// steps according to Gherkin scenarios.
+// steps according to Gherkin scenarios. Tags holds tags on the
+// lines preceding the feature. Scenarios includes scenarios of
+// all rules.
 type Feature struct {
+	Location
 	Name        string
 	Description string
+	Tags        []string
 	Scenarios   []Scenario
 }
 
@@ -50,6 +123,29 @@ func (feature Feature) String() string {
 	return fmt.Sprintf("Feature: %s\n%s\n", feature.Name, feature.Description)
 }
 
+// locate returns a copy of feature, where file of all locations are set to path.
+func (feature Feature) locate(path string) Feature {
+	feature.File = path
+	scenarios := []Scenario{}
+
+	for _, scenario := range feature.Scenarios {
+		steps := []Step{}
+
+		for _, step := range scenario.Steps {
+			step.File = path
+			steps = append(steps, step)
+		}
+
+		scenario.File = path
+		scenario.Steps = steps
+		scenarios = append(scenarios, scenario)
+	}
+
+	feature.Scenarios = scenarios
+
+	return feature
+}
+
 // Suite interface provides measures to run feature and record test result.
 // Test results are based on bahaviours supplied by one of following commands:
 // Given, When, Then, But, And, Asterix.
@@ -61,13 +157,23 @@ type Suite interface {
 
 // NewSuite generates built-in Suite implementation.
 func NewSuite() Suite {
-	s := suite{}
-	s.missingImpl = map[string]bool{}
+	return NewSuiteWithSettings(Settings{})
+}
+
+// NewSuiteWithSettings generates built-in Suite implementation configured by settings.
+func NewSuiteWithSettings(settings Settings) Suite {
+	s := suite{settings: settings}
+	s.missingImpl = map[string][]Location{}
+	s.shuffle = settings.Shuffler("scenarios:" + settings.Feature)
 
 	return &s
 }
 
 type suite struct {
+	settings Settings
+	mutex    sync.Mutex                // Guards counters and snippets, scenarios might run in parallel
+	shuffle  func(int, func(i, j int)) // Randomises order of scenarios of all tested features
+
 	totalFeatures  int
 	totalScenarios int
 	totalSteps     int
@@ -88,7 +194,28 @@ type suite struct {
 	pendingScenarios int
 	pendingSteps     int
 
-	missingImpl map[string]bool
+	ambiguousScenarios int
+	ambiguousSteps     int // Step matching more than one step definition
+
+	skippedScenarios int // Scenarios not tested at all e.g., due to fail fast
+	skippedSteps     int
+	halted           bool // Remaining scenarios shall be skipped
+
+	flakyScenarios int // Scenarios passing after failed attempts
+
+	missingImpl map[string][]Location // Snippets and locations of undefined steps
+	undefined   []UndefinedStep       // Undefined steps in order of testing, see Settings.Snippets
+	attempts    []Attempt             // Attempts of retried scenarios, see Settings.Attempts
+}
+
+// UndefinedStep is a step lacking step definition, appended as a line of JSON to
+// the snippets file configured by Settings. Pattern is the regular expression, or
+// Cucumber Expression, of Definition, Go code registering a step definition
+// matching the step.
+type UndefinedStep struct {
+	Location
+	Pattern    string
+	Definition string
 }
 
 type byKey []string
@@ -97,8 +224,79 @@ func (a byKey) Len() int           { return len(a) }
 func (a byKey) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a byKey) Less(i, j int) bool { return a[i] < a[j] }
 
+// count increments counter, which must be one of the counters in ts.
+func (ts *suite) count(counter *int) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	*counter++
+}
+
+// stop makes stopped report true, i.e. remaining scenarios shall be skipped.
+func (ts *suite) stop() {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	ts.halted = true
+}
+
+func (ts *suite) stopped() bool {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	return ts.halted
+}
+
+// merge adds scenario and step counters, and snippets, of other suite to ts.
+func (ts *suite) merge(other *suite) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	counters := map[*int]int{
+		&ts.totalScenarios:     other.totalScenarios,
+		&ts.totalSteps:         other.totalSteps,
+		&ts.undefinedScenarios: other.undefinedScenarios,
+		&ts.undefinedSteps:     other.undefinedSteps,
+		&ts.successScenarios:   other.successScenarios,
+		&ts.successSteps:       other.successSteps,
+		&ts.optoutSteps:        other.optoutSteps,
+		&ts.failuresScenarios:  other.failuresScenarios,
+		&ts.failuresSteps:      other.failuresSteps,
+		&ts.pendingScenarios:   other.pendingScenarios,
+		&ts.pendingSteps:       other.pendingSteps,
+		&ts.ambiguousScenarios: other.ambiguousScenarios,
+		&ts.ambiguousSteps:     other.ambiguousSteps,
+		&ts.skippedScenarios:   other.skippedScenarios,
+		&ts.skippedSteps:       other.skippedSteps,
+	}
+
+	for counter, n := range counters {
+		*counter += n
+	}
+
+	for snippet, locations := range other.missingImpl {
+		ts.missingImpl[snippet] = append(ts.missingImpl[snippet], locations...)
+	}
+
+	ts.undefined = append(ts.undefined, other.undefined...)
+}
+
+// addSnippet records snippet for a missing step implementation, and location of the step.
+func (ts *suite) addSnippet(e NotImplError) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	pattern, definition := e.definition(e.style)
+	snippet := e.snippet(e.style)
+	ts.missingImpl[snippet] = append(ts.missingImpl[snippet], e.t.Location)
+	ts.undefined = append(ts.undefined, UndefinedStep{e.t.Location, pattern, definition})
+}
+
 // snippets generates behaviour snippets based on Gherkin scenario steps.
-func (ts suite) snippets() string {
+func (ts *suite) snippets() string {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
 	keys := make([]string, 0, len(ts.missingImpl))
 
 	for k := range ts.missingImpl {
@@ -107,13 +305,61 @@ func (ts suite) snippets() string {
 
 	sort.Sort(byKey(keys))
 
-	return strings.Join(keys, "\n")
+	snippets := []string{}
+
+	for _, k := range keys {
+		locations := []string{}
+
+		for _, location := range ts.missingImpl[k] {
+			if location.File != "" {
+				locations = append(locations, location.String())
+			}
+		}
+
+		if len(locations) > 0 {
+			k = "\n    // Undefined at " + strings.Join(locations, ", ") + k
+		}
+
+		snippets = append(snippets, k)
+	}
+
+	return strings.Join(snippets, "\n")
 }
 
 // String function returns test result as string, suitable to be printed to stdout.
-func (ts suite) String() string {
-	return fmt.Sprintf("    %d scenario (%d undefined, %d failures, %d pending)\n    %d steps (%d undefined, %d failures, %d pending, %d optout)",
-		ts.totalScenarios, ts.undefinedScenarios, ts.failuresScenarios, ts.pendingScenarios,
-		ts.totalSteps, ts.undefinedSteps, ts.failuresSteps, ts.pendingSteps, ts.optoutSteps,
-	)
+// Counters for uncommon results, e.g. ambiguous, are only included when non-zero.
+func (ts *suite) String() string {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	scenarios := fmt.Sprintf("%d undefined, %d failures, %d pending", ts.undefinedScenarios, ts.failuresScenarios, ts.pendingScenarios)
+	steps := fmt.Sprintf("%d undefined, %d failures, %d pending, %d optout", ts.undefinedSteps, ts.failuresSteps, ts.pendingSteps, ts.optoutSteps)
+
+	if ts.ambiguousScenarios > 0 {
+		scenarios += fmt.Sprintf(", %d ambiguous", ts.ambiguousScenarios)
+	}
+
+	if ts.ambiguousSteps > 0 {
+		steps += fmt.Sprintf(", %d ambiguous", ts.ambiguousSteps)
+	}
+
+	if ts.skippedScenarios > 0 {
+		scenarios += fmt.Sprintf(", %d skipped", ts.skippedScenarios)
+	}
+
+	if ts.skippedSteps > 0 {
+		steps += fmt.Sprintf(", %d skipped", ts.skippedSteps)
+	}
+
+	if ts.flakyScenarios > 0 {
+		scenarios += fmt.Sprintf(", %d flaky", ts.flakyScenarios)
+	}
+
+	summary := fmt.Sprintf("    %d scenario (%s)\n    %d steps (%s)", ts.totalScenarios, scenarios, ts.totalSteps, steps)
+
+	if ts.settings.Random {
+		summary += fmt.Sprintf("\n    Randomized with seed %d", ts.settings.Seed)
+	}
+
+	return summary
 }
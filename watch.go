@@ -0,0 +1,388 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"gomate.io/gomate/compiler/definition"
+	"gomate.io/gomate/compiler/feature"
+	"gomate.io/gomate/logging"
+)
+
+const clearScreen = "\033[H\033[2J"
+
+// watchCMD tests feature files of targets, and tests them again each time files change.
+// Feature files, step definitions and Go files of the project are polled every interval,
+// and changes are collected until an interval passes without further changes. Only
+// changed feature files are tested, unless step definitions changed, in which case the
+// definitions are compiled again and all features using them are tested. Changes to
+// other Go files compiles and tests everything, as step definitions might use them.
+func watchCMD(c *cli.Context) error {
+	setupGlobals(c)
+
+	if err := setupSuite(c); err != nil {
+		return err
+	}
+
+	interval := c.Duration("interval")
+	if interval <= 0 {
+		return cli.Exit(fmt.Sprintf("invalid --interval %s, expected a positive duration", interval), 1)
+	}
+
+	targets := c.Args().Slice()
+	if len(targets) == 0 {
+		targets = []string{c.String("dir")}
+	}
+
+	targets, err := expandTargets(targets)
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	w := &watcher{targets: targets, compiled: map[string]*definition.Definitions{}, listings: map[string]listing{}}
+	defer w.remove()
+
+	if w.groups, err = groupFeatures(targets); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	w.dirs = w.stampDirs()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	files := w.files()
+	w.test(nil)
+
+	for {
+		changed := map[string]bool{}
+
+		// Debounced, editors often writes several files, or a file several times, when saving
+		for {
+			select {
+			case <-signals:
+				return nil
+			case <-time.After(interval):
+			}
+
+			current := w.files()
+			found := changes(files, current)
+			files = current
+
+			for _, file := range found {
+				changed[file] = true
+			}
+
+			if len(found) == 0 && len(changed) > 0 {
+				break
+			}
+		}
+
+		w.test(changed)
+	}
+}
+
+// watcher holds features of targets and step definitions compiled by earlier tests,
+// keyed by their files. Targets are collected again only when one of their
+// directories change, and the last features collected are kept if that fails,
+// e.g. while a feature file is renamed.
+type watcher struct {
+	targets  []string
+	groups   []feature.List
+	dirs     map[string]stamp   // Directories of targets, features and step definitions
+	err      error              // Error collecting targets, if the last attempt failed
+	listings map[string]listing // Directories with Go files, keyed by path
+	compiled map[string]*definition.Definitions
+}
+
+// stamp identifies a version of a file.
+type stamp struct {
+	modified time.Time
+	size     int64
+}
+
+func stampOf(info os.FileInfo) stamp {
+	return stamp{info.ModTime(), info.Size()}
+}
+
+func (s stamp) equal(other stamp) bool {
+	return s.modified.Equal(other.modified) && s.size == other.size
+}
+
+// listing caches Go files and subdirectories of a directory, while
+// the directory is unchanged.
+type listing struct {
+	stamp stamp
+	files []string
+	dirs  []string
+}
+
+// files returns stamps of feature files and step definitions of targets,
+// and of Go files in the current directory, keyed by path.
+func (w *watcher) files() map[string]stamp {
+	if dirs := w.stampDirs(); len(dirs) != len(w.dirs) {
+		w.collect()
+	} else {
+		for dir, s := range dirs {
+			if previous, ok := w.dirs[dir]; !ok || !previous.equal(s) {
+				w.collect()
+				break
+			}
+		}
+	}
+
+	files := map[string]stamp{}
+
+	for _, list := range w.groups {
+		for _, file := range append(list.Features, list.Definitions...) {
+			if info, err := os.Stat(file); err == nil {
+				files[file] = stampOf(info)
+			}
+		}
+	}
+
+	listings := map[string]listing{}
+	w.walk(cwd, files, listings)
+	w.listings = listings // Forgets removed directories
+
+	return files
+}
+
+// collect collects features of targets again. Features collected earlier are
+// kept on errors, which are logged by the next test.
+func (w *watcher) collect() {
+	groups, err := groupFeatures(w.targets)
+	if w.err = err; err == nil {
+		w.groups = groups
+	}
+
+	w.dirs = w.stampDirs() // Collected again on next change, not on every poll
+}
+
+// stampDirs returns stamps of directories named by targets, and of the
+// directories holding features and step definitions, keyed by path.
+func (w *watcher) stampDirs() map[string]stamp {
+	dirs := map[string]stamp{}
+
+	add := func(dir string) {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			dirs[dir] = stampOf(info)
+		}
+	}
+
+	for _, target := range w.targets {
+		if dir, err := filepath.Abs(target); err == nil {
+			add(dir)
+		}
+	}
+
+	for _, list := range w.groups {
+		for _, file := range list.Features {
+			add(filepath.Dir(file))
+			add(filepath.Join(filepath.Dir(file), settings.DefPattern))
+		}
+	}
+
+	return dirs
+}
+
+// walk adds stamps of Go files in dir and its subdirectories to files, and the
+// listings of the directories to listings. Entries of directories are only read
+// when changed since listed by w. Hidden, vendor and testdata directories are
+// skipped.
+func (w *watcher) walk(dir string, files map[string]stamp, listings map[string]listing) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return // Removed while walking
+	}
+
+	l, ok := w.listings[dir]
+
+	if !ok || !l.stamp.equal(stampOf(info)) {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			logging.Err(err.Error())
+			return
+		}
+
+		l = listing{stamp: stampOf(info)}
+
+		for _, entry := range entries {
+			name := entry.Name()
+
+			switch {
+			case entry.IsDir() && !strings.HasPrefix(name, ".") && name != "vendor" && name != "testdata":
+				l.dirs = append(l.dirs, name)
+			case !entry.IsDir() && strings.HasSuffix(name, ".go"):
+				l.files = append(l.files, name)
+			}
+		}
+	}
+
+	listings[dir] = l
+
+	for _, name := range l.files {
+		if info, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			files[filepath.Join(dir, name)] = stampOf(info)
+		}
+	}
+
+	for _, name := range l.dirs {
+		w.walk(filepath.Join(dir, name), files, listings)
+	}
+}
+
+// changes returns paths of files added, removed or modified from before to after.
+func changes(before, after map[string]stamp) []string {
+	changed := []string{}
+
+	for path, s := range after {
+		if previous, ok := before[path]; !ok || !previous.equal(s) {
+			changed = append(changed, path)
+		}
+	}
+
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+
+	return changed
+}
+
+// test compiles step definitions affected by changed files, and tests feature files
+// affected by them. All feature files are tested when changed is nil. Failures are
+// logged, the watcher keeps watching.
+func (w *watcher) test(changed map[string]bool) {
+	groups := existing(w.groups)
+	shared := changed == nil // Go files used by all step definitions changed
+
+	definitions := map[string]bool{}
+
+	for _, list := range groups {
+		for _, file := range list.Definitions {
+			definitions[file] = true
+		}
+	}
+
+	for file := range changed {
+		if strings.HasSuffix(file, ".go") && !definitions[file] {
+			shared = true
+		}
+	}
+
+	os.Stdout.WriteString(clearScreen)
+
+	if w.err != nil {
+		logging.Err(w.err.Error())
+	}
+
+	if invalid := parseFeatures(groups); invalid > 0 {
+		logging.Errf("%d feature files could not be parsed", invalid)
+		w.waiting()
+
+		return
+	}
+
+	tests := []test{}
+	keys := map[string]bool{}
+
+	for _, list := range groups {
+		key := strings.Join(list.Definitions, "\n")
+		keys[key] = true
+
+		compiled, ok := w.compiled[key]
+		rebuild := !ok || shared || w.modified(list, changed)
+
+		if rebuild {
+			if ok && !settings.Forensic {
+				compiled.Remove()
+			}
+
+			definitions := compileDefinitions(list.Definitions)
+			compiled, w.compiled[key] = &definitions, &definitions
+		}
+
+		for _, file := range list.Features {
+			if rebuild || changed[file] {
+				tests = append(tests, test{compiled, file, list.Lines[file]})
+			}
+		}
+	}
+
+	// Step definitions no longer used by any feature file
+	for key, compiled := range w.compiled {
+		if !keys[key] {
+			if !settings.Forensic {
+				compiled.Remove()
+			}
+
+			delete(w.compiled, key)
+		}
+	}
+
+	if err := runTests(tests); err != nil {
+		logging.Err(err.Error())
+	}
+
+	w.waiting()
+}
+
+// existing returns copies of groups without files removed since they were
+// collected, which would otherwise fail parsing and compilation.
+func existing(groups []feature.List) []feature.List {
+	exists := func(files []string) []string {
+		found := []string{}
+
+		for _, file := range files {
+			if _, err := os.Stat(file); err == nil {
+				found = append(found, file)
+			}
+		}
+
+		return found
+	}
+
+	copies := []feature.List{}
+
+	for _, list := range groups {
+		copies = append(copies, feature.List{Features: exists(list.Features), Definitions: exists(list.Definitions), Lines: list.Lines})
+	}
+
+	return copies
+}
+
+// modified returns true if any step definition of list changed.
+func (w *watcher) modified(list feature.List, changed map[string]bool) bool {
+	for _, file := range list.Definitions {
+		if changed[file] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *watcher) waiting() {
+	logging.Infof("Watching %s for changes, press Ctrl+C to stop", strings.Join(w.targets, ", "))
+}
+
+// remove removes compiled step definitions, unless in forensic mode.
+func (w *watcher) remove() {
+	if settings.Forensic {
+		return
+	}
+
+	for _, compiled := range w.compiled {
+		compiled.Remove()
+	}
+}